@@ -4,24 +4,48 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"expvar"
 	"fmt"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kelseyhightower/envconfig"
 	_ "github.com/lib/pq"
+	busQuery "github.com/vitalii-tkachuk/verification-service/internal/application/bus/query"
+	deadLetterCommand "github.com/vitalii-tkachuk/verification-service/internal/application/deadletter/command"
+	deadLetterQuery "github.com/vitalii-tkachuk/verification-service/internal/application/deadletter/query"
+	jobQuery "github.com/vitalii-tkachuk/verification-service/internal/application/job/query"
+	sagaQuery "github.com/vitalii-tkachuk/verification-service/internal/application/saga/query"
+	schedulerCommand "github.com/vitalii-tkachuk/verification-service/internal/application/scheduler/command"
+	schedulerQuery "github.com/vitalii-tkachuk/verification-service/internal/application/scheduler/query"
+	appBus "github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/retry"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/saga"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/events"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/projection"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+	verificationSaga "github.com/vitalii-tkachuk/verification-service/internal/application/verification/saga"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/service"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/cache"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/config"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/idempotency"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+	infrastructureScheduler "github.com/vitalii-tkachuk/verification-service/internal/infrastructure/scheduler"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/server"
 )
 
 var (
 	ErrCannotParseConfig       = errors.New("cannot parse config")
 	ErrCannotConnectToDatabase = errors.New("cannot connect to database")
+	ErrCannotRegisterHandler   = errors.New("cannot register handler")
+	ErrUnknownCommandQueue     = errors.New("unknown command queue driver")
 )
 
 // Run parses config environment variables, opens database connection and setup DI service for Buses
@@ -37,30 +61,373 @@ func Run() error {
 		return fmt.Errorf("%s: %w", ErrCannotConnectToDatabase, err)
 	}
 
-	inMemoryCommandBus := bus.NewInMemoryCommandBus()
-	queryBus := bus.NewQueryBus()
+	inMemoryQueryBus := bus.NewQueryBus()
+	queryBus := bus.NewCachingQueryBus(inMemoryQueryBus, cache.NewLRU(cfg.QueryCacheSize), queryCacheTTLs(cfg))
+	expvar.Publish("queryCache", expvar.Func(func() any { return queryBus.Stats() }))
 
 	verificationRepository := postgres.NewVerificationRepository(db, cfg.DatabaseTimeout)
+	jobRepository := postgres.NewJobRepository(db, cfg.DatabaseTimeout)
+	sagaRepository := postgres.NewSagaRepository(db, cfg.DatabaseTimeout)
+	scheduledCommandRepository := postgres.NewScheduledCommandRepository(db, cfg.DatabaseTimeout)
+	verificationViewRepository := postgres.NewVerificationViewRepository(db, cfg.DatabaseTimeout, cfg.SearchLanguage)
+	verificationStatsRepository := postgres.NewVerificationStatsRepository(db, cfg.DatabaseTimeout)
+	verificationHistoryRepository := postgres.NewVerificationHistoryRepository(db, cfg.DatabaseTimeout)
+	deadLetterRepository := postgres.NewDeadLetterRepository(db, cfg.DatabaseTimeout)
+	verificationEventStore := postgres.NewVerificationEventStore(db, cfg.DatabaseTimeout)
+
+	eventBroker := events.NewBroker(verificationEventStore, events.Options{
+		PollInterval:      cfg.EventsPollInterval,
+		HeartbeatInterval: cfg.EventsHeartbeatInterval,
+		BatchSize:         cfg.EventsBatchSize,
+		BufferSize:        cfg.EventsBufferSize,
+	})
+
+	commandCodec := appBus.NewCommandCodec()
+	appBus.RegisterCommandCodec[command.CreateVerificationCommand](commandCodec)
+	appBus.RegisterCommandCodec[command.ApproveVerificationCommand](commandCodec)
+	appBus.RegisterCommandCodec[command.DeclineVerificationCommand](commandCodec)
+	appBus.RegisterCommandCodec[command.CancelVerificationCommand](commandCodec)
+
+	inMemoryCommandBus := bus.NewInMemoryCommandBus()
+	unitOfWork := postgres.NewUnitOfWork(db)
+	projectingCommandBus := bus.NewProjectingCommandBus(
+		inMemoryCommandBus,
+		projection.NewVerificationViewProjector(verificationRepository, verificationViewRepository),
+	)
+	sagaManager := saga.NewManager(sagaRepository, unitOfWork, verificationSaga.NewExpirySaga(cfg.VerificationExpiry))
+	transactionalSagaCommandBus := bus.NewTransactionalSagaCommandBus(projectingCommandBus, sagaManager)
+	transactionalCommandBus := bus.NewTransactionalCommandBus(transactionalSagaCommandBus, unitOfWork)
+	retryingCommandBus := bus.NewRetryingCommandBus(
+		transactionalCommandBus,
+		commandRetryPolicies(cfg),
+		unitOfWork,
+		deadLetterRepository,
+		commandCodec,
+	)
+	cacheInvalidatingCommandBus := bus.NewCacheInvalidatingCommandBus(retryingCommandBus, queryBus, unitOfWork)
+
+	commandBus := bus.NewSagaCommandBus(cacheInvalidatingCommandBus, sagaManager, cfg.SagaTimeoutInterval)
+
+	commandScheduler := scheduler.NewScheduler(scheduledCommandRepository, commandCodec, commandBus)
 
-	createVerificationService := service.NewCreateVerificationService(verificationRepository)
-	approveVerificationService := service.NewApproveVerificationService(verificationRepository)
-	declineVerificationService := service.NewDeclineVerificationService(verificationRepository)
+	createVerificationService := service.NewCreateVerificationService(verificationRepository, verificationHistoryRepository)
+	approveVerificationService := service.NewApproveVerificationService(verificationRepository, verificationHistoryRepository)
+	declineVerificationService := service.NewDeclineVerificationService(verificationRepository, verificationHistoryRepository)
+	cancelVerificationService := service.NewCancelVerificationService(verificationRepository, verificationHistoryRepository)
 
 	createVerificationCommandHandler := command.NewCreateVerificationCommandHandler(createVerificationService)
 	approveVerificationCommandHandler := command.NewApproveVerificationCommandHandler(approveVerificationService)
 	declineVerificationCommandHandler := command.NewDeclineVerificationCommandHandler(declineVerificationService)
+	cancelVerificationCommandHandler := command.NewCancelVerificationCommandHandler(cancelVerificationService)
+	scheduleCommandCommandHandler := schedulerCommand.NewScheduleCommandCommandHandler(commandScheduler, commandCodec)
+	cancelScheduledCommandCommandHandler := schedulerCommand.NewCancelScheduledCommandCommandHandler(scheduledCommandRepository)
+	triggerScheduledCommandCommandHandler := schedulerCommand.NewTriggerScheduledCommandCommandHandler(scheduledCommandRepository)
+	replayDeadLetterCommandHandler := deadLetterCommand.NewReplayDeadLetterCommandHandler(
+		deadLetterRepository,
+		commandCodec,
+		commandBus,
+	)
+	discardDeadLetterCommandHandler := deadLetterCommand.NewDiscardDeadLetterCommandHandler(deadLetterRepository)
 
 	getVerificationByUUIDQueryHandler := query.NewGetVerificationByUUIDQueryHandler(verificationRepository)
+	listVerificationsQueryHandler := query.NewListVerificationsQueryHandler(verificationRepository)
+	exportVerificationsQueryHandler := query.NewExportVerificationsQueryHandler(verificationRepository)
+	searchVerificationsQueryHandler := query.NewSearchVerificationsQueryHandler(verificationViewRepository)
+	verificationStatsQueryHandler := query.NewVerificationStatsQueryHandler(verificationStatsRepository)
+	getVerificationTimelineQueryHandler := query.NewGetVerificationTimelineQueryHandler(
+		verificationRepository,
+		verificationHistoryRepository,
+	)
+	listVerificationViewsQueryHandler := query.NewListVerificationViewsQueryHandler(verificationViewRepository)
+	countVerificationViewsQueryHandler := query.NewCountVerificationViewsQueryHandler(verificationViewRepository)
+	streamVerificationEventsQueryHandler := query.NewStreamVerificationEventsQueryHandler(
+		verificationRepository,
+		eventBroker,
+	)
+	getJobByIDQueryHandler := jobQuery.NewGetJobByIDQueryHandler(jobRepository)
+	listSagasQueryHandler := sagaQuery.NewListSagasQueryHandler(sagaRepository, cfg.SagaStuckAfter)
+	listScheduledCommandsQueryHandler := schedulerQuery.NewListScheduledCommandsQueryHandler(scheduledCommandRepository)
+	listDeadLettersQueryHandler := deadLetterQuery.NewListDeadLettersQueryHandler(deadLetterRepository)
+	getDeadLetterByIDQueryHandler := deadLetterQuery.NewGetDeadLetterByIDQueryHandler(deadLetterRepository)
 
-	inMemoryCommandBus.Register(command.CreateVerificationCommandType, createVerificationCommandHandler)
-	inMemoryCommandBus.Register(command.ApproveVerificationCommandType, approveVerificationCommandHandler)
-	inMemoryCommandBus.Register(command.DeclineVerificationCommandType, declineVerificationCommandHandler)
+	listBusHandlersQueryHandler := busQuery.NewListBusHandlersQueryHandler(inMemoryCommandBus, inMemoryQueryBus)
 
-	queryBus.Register(query.GetVerificationByUUIDQueryType, getVerificationByUUIDQueryHandler)
+	registrations := []func() error{
+		func() error {
+			return appBus.RegisterCommandHandler[command.CreateVerificationCommand](commandBus, createVerificationCommandHandler)
+		},
+		func() error {
+			return appBus.RegisterCommandHandler[command.ApproveVerificationCommand](commandBus, approveVerificationCommandHandler)
+		},
+		func() error {
+			return appBus.RegisterCommandHandler[command.DeclineVerificationCommand](commandBus, declineVerificationCommandHandler)
+		},
+		func() error {
+			return appBus.RegisterCommandHandler[command.CancelVerificationCommand](commandBus, cancelVerificationCommandHandler)
+		},
+		func() error {
+			return appBus.RegisterCommandHandler[schedulerCommand.ScheduleCommandCommand](commandBus, scheduleCommandCommandHandler)
+		},
+		func() error {
+			return appBus.RegisterCommandHandler[schedulerCommand.CancelScheduledCommandCommand](
+				commandBus,
+				cancelScheduledCommandCommandHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterCommandHandler[schedulerCommand.TriggerScheduledCommandCommand](
+				commandBus,
+				triggerScheduledCommandCommandHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterCommandHandler[deadLetterCommand.ReplayDeadLetterCommand](
+				commandBus,
+				replayDeadLetterCommandHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterCommandHandler[deadLetterCommand.DiscardDeadLetterCommand](
+				commandBus,
+				discardDeadLetterCommandHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.GetVerificationByUUIDQuery, *aggregate.Verification](
+				queryBus,
+				getVerificationByUUIDQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.ListVerificationsQuery, query.VerificationsPage](
+				queryBus,
+				listVerificationsQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.SearchVerificationsQuery, []*readmodel.SearchResult](
+				queryBus,
+				searchVerificationsQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.VerificationStatsQuery, query.VerificationStats](
+				queryBus,
+				verificationStatsQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.GetVerificationTimelineQuery, []query.TimelineEntry](
+				queryBus,
+				getVerificationTimelineQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.ExportVerificationsQuery, uint](
+				queryBus,
+				exportVerificationsQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.ListVerificationViewsQuery, []*readmodel.VerificationView](
+				queryBus,
+				listVerificationViewsQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.CountVerificationViewsQuery, map[string]uint](
+				queryBus,
+				countVerificationViewsQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[query.StreamVerificationEventsQuery, uint](
+				queryBus,
+				streamVerificationEventsQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[jobQuery.GetJobByIDQuery, *appBus.Job](queryBus, getJobByIDQueryHandler)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[sagaQuery.ListSagasQuery, []sagaQuery.SagaView](queryBus, listSagasQueryHandler)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[schedulerQuery.ListScheduledCommandsQuery, []*scheduler.Entry](
+				queryBus,
+				listScheduledCommandsQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[busQuery.ListBusHandlersQuery, busQuery.BusHandlers](
+				queryBus,
+				listBusHandlersQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[deadLetterQuery.ListDeadLettersQuery, []*deadletter.Letter](
+				queryBus,
+				listDeadLettersQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.RegisterQueryHandler[deadLetterQuery.GetDeadLetterByIDQuery, *deadletter.Letter](
+				queryBus,
+				getDeadLetterByIDQueryHandler,
+			)
+		},
+		func() error {
+			return appBus.CheckCommandHandlers(inMemoryCommandBus, knownCommandTypes()...)
+		},
+		func() error {
+			return appBus.CheckQueryHandlers(inMemoryQueryBus, knownQueryTypes()...)
+		},
+	}
+
+	for _, register := range registrations {
+		if err := register(); err != nil {
+			return fmt.Errorf("%s: %w", ErrCannotRegisterHandler, err)
+		}
+	}
+
+	asyncCommandBus, asyncShutdownHooks, err := newAsyncCommandBus(cfg, db, commandBus, jobRepository, commandCodec)
+	if err != nil {
+		return err
+	}
 
-	application := infrastructure.NewApplication(inMemoryCommandBus, queryBus, validator.New())
+	application := infrastructure.NewApplication(commandBus, asyncCommandBus, queryBus, unitOfWork, validator.New())
 
-	ctx, srv := server.NewServer(context.Background(), cfg, application)
+	idempotencyKeyRepository := postgres.NewIdempotencyKeyRepository(db, cfg.DatabaseTimeout)
+
+	if err := eventBroker.Start(context.Background()); err != nil {
+		return fmt.Errorf("%s: %w", ErrCannotConnectToDatabase, err)
+	}
+
+	ctx, srv := server.NewServer(context.Background(), cfg, application, idempotencyKeyRepository)
+	poller := infrastructureScheduler.NewPoller(commandScheduler, cfg.SchedulerInterval, cfg.SchedulerBatchSize)
+	idempotencyKeyPurger := idempotency.NewPurger(idempotencyKeyRepository, cfg.IdempotencyKeyPurgeInterval)
+
+	srv.RegisterOnShutdown(eventBroker.Close)
+	srv.RegisterShutdownHook(poller.Shutdown)
+	srv.RegisterShutdownHook(idempotencyKeyPurger.Shutdown)
+
+	for _, hook := range asyncShutdownHooks {
+		srv.RegisterShutdownHook(hook)
+	}
+
+	srv.RegisterShutdownHook(commandBus.Shutdown)
 
 	return srv.Run(ctx)
 }
+
+// newAsyncCommandBus creates bus.AsyncCommandBus of configured command queue driver and returns shutdown hooks
+// draining it. Durable postgres queue workers are woken up by database notifications.
+func newAsyncCommandBus(
+	cfg config.Config,
+	db *sql.DB,
+	commandBus appBus.CommandBus,
+	jobRepository appBus.JobRepository,
+	codec *appBus.CommandCodec,
+) (appBus.AsyncCommandBus, []func(context.Context) error, error) {
+	switch cfg.CommandQueueDriver {
+	case "memory":
+		asyncCommandBus := bus.NewAsyncCommandBus(commandBus, jobRepository, cfg.CommandBusWorkers, cfg.CommandBusQueueSize)
+
+		return asyncCommandBus, []func(context.Context) error{asyncCommandBus.Shutdown}, nil
+	case "postgres":
+		listener, err := postgres.NewCommandQueueListener(cfg.PostgresDatabaseDsn(), time.Second, time.Minute)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", ErrCannotConnectToDatabase, err)
+		}
+
+		queueCommandBus := bus.NewQueueCommandBus(
+			commandBus,
+			postgres.NewCommandQueue(db, cfg.DatabaseTimeout),
+			codec,
+			jobRepository,
+			listener.Wakeups(),
+			bus.QueueOptions{
+				Workers:           cfg.CommandBusWorkers,
+				PollInterval:      cfg.CommandQueuePollInterval,
+				VisibilityTimeout: cfg.CommandQueueVisibilityTimeout,
+				MaxDeliveries:     cfg.CommandQueueMaxDeliveries,
+			},
+		)
+		queueCommandBus.Start()
+
+		closeListener := func(context.Context) error {
+			return listener.Close()
+		}
+
+		return queueCommandBus, []func(context.Context) error{queueCommandBus.Shutdown, closeListener}, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnknownCommandQueue, cfg.CommandQueueDriver)
+	}
+}
+
+// knownCommandTypes collects command types of every application package, each must have a handler before start.
+func knownCommandTypes() []appBus.CommandType {
+	var commandTypes []appBus.CommandType
+
+	for _, types := range [][]appBus.CommandType{
+		command.CommandTypes,
+		schedulerCommand.CommandTypes,
+		deadLetterCommand.CommandTypes,
+	} {
+		commandTypes = append(commandTypes, types...)
+	}
+
+	return commandTypes
+}
+
+// knownQueryTypes collects query types of every application package, each must have a handler before start.
+func knownQueryTypes() []appBus.QueryType {
+	var queryTypes []appBus.QueryType
+
+	for _, types := range [][]appBus.QueryType{
+		query.QueryTypes,
+		jobQuery.QueryTypes,
+		sagaQuery.QueryTypes,
+		schedulerQuery.QueryTypes,
+		busQuery.QueryTypes,
+		deadLetterQuery.QueryTypes,
+	} {
+		queryTypes = append(queryTypes, types...)
+	}
+
+	return queryTypes
+}
+
+// queryCacheTTLs converts query cache TTLs config to bus.QueryType keyed map.
+func queryCacheTTLs(cfg config.Config) map[appBus.QueryType]time.Duration {
+	ttls := make(map[appBus.QueryType]time.Duration, len(cfg.QueryCacheTTLs))
+
+	for queryType, ttl := range cfg.QueryCacheTTLs {
+		ttls[appBus.QueryType(queryType)] = ttl
+	}
+
+	return ttls
+}
+
+// commandRetryPolicies builds retry policies of command types from config.
+// Only transient database failures are retried, domain errors are returned to caller at once.
+func commandRetryPolicies(cfg config.Config) retry.Policies {
+	defaultPolicy := retry.Policy{
+		MaxAttempts:    cfg.CommandRetryMaxAttempts,
+		InitialBackoff: cfg.CommandRetryInitialBackoff,
+		MaxBackoff:     cfg.CommandRetryMaxBackoff,
+		Multiplier:     cfg.CommandRetryMultiplier,
+		Jitter:         cfg.CommandRetryJitter,
+		Retryable:      postgres.IsTransient,
+	}
+
+	policies := make(map[appBus.CommandType]retry.Policy, len(cfg.CommandRetryAttempts))
+
+	for commandType, attempts := range cfg.CommandRetryAttempts {
+		policy := defaultPolicy
+		policy.MaxAttempts = attempts
+		policies[appBus.CommandType(commandType)] = policy
+	}
+
+	return retry.NewPolicies(defaultPolicy, policies)
+}
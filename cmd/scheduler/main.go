@@ -0,0 +1,14 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/cli"
+)
+
+func main() {
+	if err := cli.RunScheduler(os.Args[1:], os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
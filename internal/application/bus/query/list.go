@@ -0,0 +1,52 @@
+package query
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+const ListBusHandlersQueryType bus.QueryType = "list.bus_handler.query"
+
+// BusHandlers represents handlers registered in command and query buses.
+type BusHandlers struct {
+	Commands []bus.HandlerInfo
+	Queries  []bus.HandlerInfo
+}
+
+// ListBusHandlersQuery is the query dispatched to list registered command and query handlers.
+type ListBusHandlersQuery struct {
+	bus.Returns[BusHandlers]
+}
+
+// NewListBusHandlersQuery creates a new ListBusHandlersQuery.
+func NewListBusHandlersQuery() ListBusHandlersQuery {
+	return ListBusHandlersQuery{}
+}
+
+// Type implements bus.Query interface.
+func (q ListBusHandlersQuery) Type() bus.QueryType {
+	return ListBusHandlersQueryType
+}
+
+// ListBusHandlersQueryHandler is the ListBusHandlersQuery handler.
+type ListBusHandlersQueryHandler struct {
+	commandRegistry bus.HandlerRegistry
+	queryRegistry   bus.HandlerRegistry
+}
+
+// NewListBusHandlersQueryHandler initializes a new ListBusHandlersQueryHandler.
+func NewListBusHandlersQueryHandler(commandRegistry, queryRegistry bus.HandlerRegistry) ListBusHandlersQueryHandler {
+	return ListBusHandlersQueryHandler{
+		commandRegistry: commandRegistry,
+		queryRegistry:   queryRegistry,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h ListBusHandlersQueryHandler) Handle(context.Context, ListBusHandlersQuery) (BusHandlers, error) {
+	return BusHandlers{
+		Commands: h.commandRegistry.Handlers(),
+		Queries:  h.queryRegistry.Handlers(),
+	}, nil
+}
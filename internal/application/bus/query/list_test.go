@@ -0,0 +1,30 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+type staticRegistry []bus.HandlerInfo
+
+func (r staticRegistry) Handlers() []bus.HandlerInfo {
+	return r
+}
+
+func TestHandleListBusHandlersQuerySuccess(t *testing.T) {
+	// assign
+	commands := staticRegistry{{Type: "create.verification.command", Handler: "command.CreateVerificationCommandHandler"}}
+	queries := staticRegistry{{Type: string(ListBusHandlersQueryType), Handler: "query.ListBusHandlersQueryHandler"}}
+
+	// act
+	listBusHandlersQueryHandler := NewListBusHandlersQueryHandler(commands, queries)
+	handlers, err := listBusHandlersQueryHandler.Handle(context.Background(), NewListBusHandlersQuery())
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, BusHandlers{Commands: commands, Queries: queries}, handlers)
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+)
+
+const DiscardDeadLetterCommandType bus.CommandType = "discard.dead_letter.command"
+
+// DiscardDeadLetterCommand is the command dispatched to delete dead-lettered command without dispatching it.
+type DiscardDeadLetterCommand struct {
+	id string
+}
+
+// NewDiscardDeadLetterCommand creates a new DiscardDeadLetterCommand.
+func NewDiscardDeadLetterCommand(ID string) DiscardDeadLetterCommand {
+	return DiscardDeadLetterCommand{
+		id: ID,
+	}
+}
+
+// Type implements bus.Command interface.
+func (c DiscardDeadLetterCommand) Type() bus.CommandType {
+	return DiscardDeadLetterCommandType
+}
+
+// Validate implements bus.Validatable interface.
+func (c DiscardDeadLetterCommand) Validate() error {
+	return validateDeadLetterID(c.id)
+}
+
+// DiscardDeadLetterCommandHandler is the DiscardDeadLetterCommand handler.
+type DiscardDeadLetterCommandHandler struct {
+	repository deadletter.Repository
+}
+
+// NewDiscardDeadLetterCommandHandler initializes a new DiscardDeadLetterCommandHandler.
+func NewDiscardDeadLetterCommandHandler(repository deadletter.Repository) DiscardDeadLetterCommandHandler {
+	return DiscardDeadLetterCommandHandler{
+		repository: repository,
+	}
+}
+
+// Handle implements the bus.TypedCommandHandler interface.
+func (h DiscardDeadLetterCommandHandler) Handle(ctx context.Context, discardDeadLetterCommand DiscardDeadLetterCommand) error {
+	return h.repository.Delete(ctx, discardDeadLetterCommand.id)
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleDiscardDeadLetterCommandSuccess(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+	deadLetterRepositoryMock.On("Delete", mock.Anything, id).Return(nil)
+
+	// act
+	discardDeadLetterCommandHandler := NewDiscardDeadLetterCommandHandler(deadLetterRepositoryMock)
+	err := discardDeadLetterCommandHandler.Handle(context.Background(), NewDiscardDeadLetterCommand(id))
+
+	// assert
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestHandleDiscardDeadLetterCommandNotFoundError(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+	deadLetterRepositoryMock.On("Delete", mock.Anything, id).Return(deadletter.ErrLetterNotFound)
+
+	// act
+	discardDeadLetterCommandHandler := NewDiscardDeadLetterCommandHandler(deadLetterRepositoryMock)
+	err := discardDeadLetterCommandHandler.Handle(context.Background(), NewDiscardDeadLetterCommand(id))
+
+	// assert
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, deadletter.ErrLetterNotFound)
+}
+
+func TestDiscardDeadLetterCommandValidationError(t *testing.T) {
+	// assign
+	discardDeadLetterCommand := NewDiscardDeadLetterCommand("invalid")
+
+	// act
+	err := discardDeadLetterCommand.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+}
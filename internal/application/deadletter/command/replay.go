@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+)
+
+const ReplayDeadLetterCommandType bus.CommandType = "replay.dead_letter.command"
+
+var ErrInvalidDeadLetterID = errors.New("invalid dead letter id")
+
+// ReplayDeadLetterCommand is the command dispatched to dispatch dead-lettered command once again.
+type ReplayDeadLetterCommand struct {
+	id string
+}
+
+// NewReplayDeadLetterCommand creates a new ReplayDeadLetterCommand.
+func NewReplayDeadLetterCommand(ID string) ReplayDeadLetterCommand {
+	return ReplayDeadLetterCommand{
+		id: ID,
+	}
+}
+
+// Type implements bus.Command interface.
+func (c ReplayDeadLetterCommand) Type() bus.CommandType {
+	return ReplayDeadLetterCommandType
+}
+
+// Validate implements bus.Validatable interface.
+func (c ReplayDeadLetterCommand) Validate() error {
+	return validateDeadLetterID(c.id)
+}
+
+// ReplayDeadLetterCommandHandler is the ReplayDeadLetterCommand handler.
+type ReplayDeadLetterCommandHandler struct {
+	repository deadletter.Repository
+	codec      *bus.CommandCodec
+	commandBus bus.CommandBus
+}
+
+// NewReplayDeadLetterCommandHandler initializes a new ReplayDeadLetterCommandHandler.
+func NewReplayDeadLetterCommandHandler(
+	repository deadletter.Repository,
+	codec *bus.CommandCodec,
+	commandBus bus.CommandBus,
+) ReplayDeadLetterCommandHandler {
+	return ReplayDeadLetterCommandHandler{
+		repository: repository,
+		codec:      codec,
+		commandBus: commandBus,
+	}
+}
+
+// Handle implements the bus.TypedCommandHandler interface.
+// Letter is deleted only if its command succeeds, both changes share the replay command transaction.
+func (h ReplayDeadLetterCommandHandler) Handle(ctx context.Context, replayDeadLetterCommand ReplayDeadLetterCommand) error {
+	letter, err := h.repository.Get(ctx, replayDeadLetterCommand.id)
+	if err != nil {
+		return err
+	}
+
+	command, err := h.codec.Decode(letter.CommandType, letter.Payload)
+	if err != nil {
+		return err
+	}
+
+	if err := h.commandBus.Dispatch(ctx, command); err != nil {
+		return err
+	}
+
+	return h.repository.Delete(ctx, letter.ID)
+}
+
+// validateDeadLetterID validates dead letter id of dead letter management commands.
+func validateDeadLetterID(id string) error {
+	var validationError bus.ValidationError
+
+	if _, err := uuid.Parse(id); err != nil {
+		validationError.Add("id", ErrInvalidDeadLetterID)
+	}
+
+	return validationError.ErrorOrNil()
+}
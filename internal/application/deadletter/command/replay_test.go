@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+var errTestCommandFailed = errors.New("test command failed")
+
+type testCommand struct {
+	Value string `json:"value"`
+}
+
+func (c testCommand) Type() bus.CommandType {
+	return "test.command"
+}
+
+func newTestLetter() *deadletter.Letter {
+	return deadletter.NewLetter(uuid.New().String(), "test.command", []byte(`{"value":"test"}`), errTestCommandFailed, 3)
+}
+
+func newTestReplayDeadLetterCommandHandler(
+	repository deadletter.Repository,
+	commandBus bus.CommandBus,
+) ReplayDeadLetterCommandHandler {
+	codec := bus.NewCommandCodec()
+	bus.RegisterCommandCodec[testCommand](codec)
+
+	return NewReplayDeadLetterCommandHandler(repository, codec, commandBus)
+}
+
+func TestHandleReplayDeadLetterCommandSuccess(t *testing.T) {
+	// assign
+	letter := newTestLetter()
+	commandBusMock := new(mocks.CommandBus)
+	commandBusMock.On("Dispatch", mock.Anything, testCommand{Value: "test"}).Return(nil)
+
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+	deadLetterRepositoryMock.On("Get", mock.Anything, letter.ID).Return(letter, nil)
+	deadLetterRepositoryMock.On("Delete", mock.Anything, letter.ID).Return(nil)
+
+	// act
+	replayDeadLetterCommandHandler := newTestReplayDeadLetterCommandHandler(deadLetterRepositoryMock, commandBusMock)
+	err := replayDeadLetterCommandHandler.Handle(context.Background(), NewReplayDeadLetterCommand(letter.ID))
+
+	// assert
+	commandBusMock.AssertExpectations(t)
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestHandleReplayDeadLetterCommandKeepsLetterOnFailure(t *testing.T) {
+	// assign
+	letter := newTestLetter()
+	commandBusMock := new(mocks.CommandBus)
+	commandBusMock.On("Dispatch", mock.Anything, testCommand{Value: "test"}).Return(errTestCommandFailed)
+
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+	deadLetterRepositoryMock.On("Get", mock.Anything, letter.ID).Return(letter, nil)
+
+	// act
+	replayDeadLetterCommandHandler := newTestReplayDeadLetterCommandHandler(deadLetterRepositoryMock, commandBusMock)
+	err := replayDeadLetterCommandHandler.Handle(context.Background(), NewReplayDeadLetterCommand(letter.ID))
+
+	// assert
+	commandBusMock.AssertExpectations(t)
+	deadLetterRepositoryMock.AssertExpectations(t)
+	deadLetterRepositoryMock.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	assert.ErrorIs(t, err, errTestCommandFailed)
+}
+
+func TestHandleReplayDeadLetterCommandNotFoundError(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+	commandBusMock := new(mocks.CommandBus)
+
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+	deadLetterRepositoryMock.On("Get", mock.Anything, id).Return(nil, deadletter.ErrLetterNotFound)
+
+	// act
+	replayDeadLetterCommandHandler := newTestReplayDeadLetterCommandHandler(deadLetterRepositoryMock, commandBusMock)
+	err := replayDeadLetterCommandHandler.Handle(context.Background(), NewReplayDeadLetterCommand(id))
+
+	// assert
+	commandBusMock.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, deadletter.ErrLetterNotFound)
+}
+
+func TestReplayDeadLetterCommandValidationError(t *testing.T) {
+	// assign
+	replayDeadLetterCommand := NewReplayDeadLetterCommand("invalid")
+
+	// act
+	err := replayDeadLetterCommand.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+}
@@ -0,0 +1,9 @@
+package command
+
+import "github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+
+// CommandTypes lists every command type of the package. Application must not start until each of them has a handler.
+var CommandTypes = []bus.CommandType{
+	ReplayDeadLetterCommandType,
+	DiscardDeadLetterCommandType,
+}
@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+)
+
+const GetDeadLetterByIDQueryType bus.QueryType = "get_by_id.dead_letter.query"
+
+var ErrInvalidDeadLetterID = errors.New("invalid dead letter id")
+
+// GetDeadLetterByIDQuery is the query dispatched to get dead-lettered command by id.
+type GetDeadLetterByIDQuery struct {
+	bus.Returns[*deadletter.Letter]
+	id string
+}
+
+// NewGetDeadLetterByIDQuery creates a new GetDeadLetterByIDQuery.
+func NewGetDeadLetterByIDQuery(ID string) GetDeadLetterByIDQuery {
+	return GetDeadLetterByIDQuery{
+		id: ID,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q GetDeadLetterByIDQuery) Type() bus.QueryType {
+	return GetDeadLetterByIDQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q GetDeadLetterByIDQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := uuid.Parse(q.id); err != nil {
+		validationError.Add("id", ErrInvalidDeadLetterID)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// GetDeadLetterByIDQueryHandler is the GetDeadLetterByIDQuery handler.
+type GetDeadLetterByIDQueryHandler struct {
+	repository deadletter.Repository
+}
+
+// NewGetDeadLetterByIDQueryHandler initializes a new GetDeadLetterByIDQueryHandler.
+func NewGetDeadLetterByIDQueryHandler(repository deadletter.Repository) GetDeadLetterByIDQueryHandler {
+	return GetDeadLetterByIDQueryHandler{
+		repository: repository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h GetDeadLetterByIDQueryHandler) Handle(
+	ctx context.Context,
+	getDeadLetterByIDQuery GetDeadLetterByIDQuery,
+) (*deadletter.Letter, error) {
+	return h.repository.Get(ctx, getDeadLetterByIDQuery.id)
+}
@@ -0,0 +1,41 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleGetDeadLetterByIDQueryNotFoundError(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+	deadLetterRepositoryMock.On("Get", mock.Anything, id).Return(nil, deadletter.ErrLetterNotFound)
+
+	// act
+	getDeadLetterByIDQueryHandler := NewGetDeadLetterByIDQueryHandler(deadLetterRepositoryMock)
+	letter, err := getDeadLetterByIDQueryHandler.Handle(context.Background(), NewGetDeadLetterByIDQuery(id))
+
+	// assert
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.Nil(t, letter)
+	assert.ErrorIs(t, err, deadletter.ErrLetterNotFound)
+}
+
+func TestGetDeadLetterByIDQueryValidationError(t *testing.T) {
+	// assign
+	getDeadLetterByIDQuery := NewGetDeadLetterByIDQuery("invalid")
+
+	// act
+	err := getDeadLetterByIDQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+}
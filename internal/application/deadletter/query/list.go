@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+)
+
+const (
+	ListDeadLettersQueryType bus.QueryType = "list.dead_letter.query"
+	listDeadLettersMaxLimit                = 1000
+)
+
+// ListDeadLettersQuery is the query dispatched to list dead-lettered commands.
+type ListDeadLettersQuery struct {
+	bus.Returns[[]*deadletter.Letter]
+	limit uint
+}
+
+// NewListDeadLettersQuery creates a new ListDeadLettersQuery.
+func NewListDeadLettersQuery(limit uint) ListDeadLettersQuery {
+	return ListDeadLettersQuery{
+		limit: limit,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q ListDeadLettersQuery) Type() bus.QueryType {
+	return ListDeadLettersQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q ListDeadLettersQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if q.limit == 0 || q.limit > listDeadLettersMaxLimit {
+		validationError.Addf("limit", bus.ErrFieldOutOfRange, "must be between 1 and %d", listDeadLettersMaxLimit)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// ListDeadLettersQueryHandler is the ListDeadLettersQuery handler.
+type ListDeadLettersQueryHandler struct {
+	repository deadletter.Repository
+}
+
+// NewListDeadLettersQueryHandler initializes a new ListDeadLettersQueryHandler.
+func NewListDeadLettersQueryHandler(repository deadletter.Repository) ListDeadLettersQueryHandler {
+	return ListDeadLettersQueryHandler{
+		repository: repository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h ListDeadLettersQueryHandler) Handle(
+	ctx context.Context,
+	listDeadLettersQuery ListDeadLettersQuery,
+) ([]*deadletter.Letter, error) {
+	return h.repository.List(ctx, listDeadLettersQuery.limit)
+}
@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleListDeadLettersQuerySuccess(t *testing.T) {
+	// assign
+	letter := deadletter.NewLetter(uuid.New().String(), "test.command", []byte(`{}`), errors.New("failed"), 3)
+
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+	deadLetterRepositoryMock.On("List", mock.Anything, uint(10)).Return([]*deadletter.Letter{letter}, nil)
+
+	// act
+	listDeadLettersQueryHandler := NewListDeadLettersQueryHandler(deadLetterRepositoryMock)
+	letters, err := listDeadLettersQueryHandler.Handle(context.Background(), NewListDeadLettersQuery(10))
+
+	// assert
+	deadLetterRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, []*deadletter.Letter{letter}, letters)
+}
+
+func TestListDeadLettersQueryValidationError(t *testing.T) {
+	// assign
+	listDeadLettersQuery := NewListDeadLettersQuery(0)
+
+	// act
+	err := listDeadLettersQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Len(t, validationError.Errors, 1)
+}
@@ -0,0 +1,9 @@
+package query
+
+import "github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+
+// QueryTypes lists every query type of the package. Application must not start until each of them has a handler.
+var QueryTypes = []bus.QueryType{
+	ListDeadLettersQueryType,
+	GetDeadLetterByIDQueryType,
+}
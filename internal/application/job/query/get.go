@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+const GetJobByIDQueryType bus.QueryType = "get_by_id.job.query"
+
+var ErrInvalidJobID = errors.New("invalid job id")
+
+// GetJobByIDQuery is the query dispatched to get background job by id.
+type GetJobByIDQuery struct {
+	bus.Returns[*bus.Job]
+	id string
+}
+
+// NewGetJobByIDQuery creates a new GetJobByIDQuery.
+func NewGetJobByIDQuery(ID string) GetJobByIDQuery {
+	return GetJobByIDQuery{
+		id: ID,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q GetJobByIDQuery) Type() bus.QueryType {
+	return GetJobByIDQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q GetJobByIDQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := uuid.Parse(q.id); err != nil {
+		validationError.Add("id", ErrInvalidJobID)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// GetJobByIDQueryHandler is the GetJobByIDQuery handler.
+type GetJobByIDQueryHandler struct {
+	jobRepository bus.JobRepository
+}
+
+// NewGetJobByIDQueryHandler initializes a new GetJobByIDQueryHandler.
+func NewGetJobByIDQueryHandler(jobRepository bus.JobRepository) GetJobByIDQueryHandler {
+	return GetJobByIDQueryHandler{
+		jobRepository: jobRepository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h GetJobByIDQueryHandler) Handle(ctx context.Context, getJobByIDQuery GetJobByIDQuery) (*bus.Job, error) {
+	return h.jobRepository.GetByID(ctx, getJobByIDQuery.id)
+}
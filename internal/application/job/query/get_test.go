@@ -0,0 +1,55 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleUnsupportedGetJobByIDQueryError(t *testing.T) {
+	// assign
+	var unsupportedQueryType bus.QueryType = "unsupported_get_by_id.job.query"
+
+	unsupportedQuery := new(mocks.Query)
+	unsupportedQuery.On("Type").Return(unsupportedQueryType)
+
+	jobRepositoryMock := new(persistence.JobRepository)
+
+	// act
+	getJobByIDQueryHandler, err := bus.NewQueryHandler[GetJobByIDQuery, *bus.Job](NewGetJobByIDQueryHandler(jobRepositoryMock))
+	require.NoError(t, err)
+	job, err := getJobByIDQueryHandler.Handle(context.Background(), unsupportedQuery)
+
+	// assert
+	jobRepositoryMock.AssertExpectations(t)
+	assert.Nil(t, job)
+	assert.ErrorIs(t, err, bus.ErrUnexpectedQuery)
+}
+
+func TestGetJobByIDQuerySuccess(t *testing.T) {
+	// assign
+	var commandType bus.CommandType = "approve.verification.command"
+
+	expectedJob := bus.NewJob(uuid.New().String(), commandType)
+
+	getJobByIDQuery := NewGetJobByIDQuery(expectedJob.ID)
+
+	jobRepositoryMock := new(persistence.JobRepository)
+	jobRepositoryMock.On("GetByID", mock.Anything, expectedJob.ID).Return(expectedJob, nil)
+
+	// act
+	getJobByIDQueryHandler := NewGetJobByIDQueryHandler(jobRepositoryMock)
+	job, err := getJobByIDQueryHandler.Handle(context.Background(), getJobByIDQuery)
+
+	// assert
+	jobRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJob, job)
+}
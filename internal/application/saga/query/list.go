@@ -0,0 +1,103 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/saga"
+)
+
+const (
+	ListSagasQueryType bus.QueryType = "list.saga.query"
+	listSagasMaxLimit                = 1000
+)
+
+// SagaView represents listed saga state together with its stuck flag.
+type SagaView struct {
+	State *saga.State
+	Stuck bool
+}
+
+// ListSagasQuery is the query dispatched to list saga states.
+type ListSagasQuery struct {
+	bus.Returns[[]SagaView]
+	status    string
+	stuckOnly bool
+	limit     uint
+}
+
+// NewListSagasQuery creates a new ListSagasQuery. Empty status lists sagas with any status.
+func NewListSagasQuery(status string, stuckOnly bool, limit uint) ListSagasQuery {
+	return ListSagasQuery{
+		status:    status,
+		stuckOnly: stuckOnly,
+		limit:     limit,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q ListSagasQuery) Type() bus.QueryType {
+	return ListSagasQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q ListSagasQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if q.status != "" {
+		if _, err := saga.NewStatus(q.status); err != nil {
+			validationError.Add("status", err)
+		}
+	}
+
+	if q.stuckOnly && q.status != "" && q.status != string(saga.Running) {
+		validationError.Addf("stuck", bus.ErrFieldInvalid, "only running sagas can be stuck")
+	}
+
+	if q.limit == 0 || q.limit > listSagasMaxLimit {
+		validationError.Addf("limit", bus.ErrFieldOutOfRange, "must be between 1 and %d", listSagasMaxLimit)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// ListSagasQueryHandler is the ListSagasQuery handler.
+type ListSagasQueryHandler struct {
+	sagaRepository saga.Repository
+	stuckAfter     time.Duration
+}
+
+// NewListSagasQueryHandler initializes a new ListSagasQueryHandler.
+// Running saga is considered stuck if its timeout is expired more than stuckAfter ago.
+func NewListSagasQueryHandler(sagaRepository saga.Repository, stuckAfter time.Duration) ListSagasQueryHandler {
+	return ListSagasQueryHandler{
+		sagaRepository: sagaRepository,
+		stuckAfter:     stuckAfter,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h ListSagasQueryHandler) Handle(ctx context.Context, listSagasQuery ListSagasQuery) ([]SagaView, error) {
+	now := time.Now()
+	filter := saga.Filter{Status: saga.Status(listSagasQuery.status), Limit: listSagasQuery.limit}
+
+	if listSagasQuery.stuckOnly {
+		stuckBefore := now.Add(-h.stuckAfter)
+		filter.Status = saga.Running
+		filter.TimeoutBefore = &stuckBefore
+	}
+
+	states, err := h.sagaRepository.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]SagaView, len(states))
+
+	for i, state := range states {
+		views[i] = SagaView{State: state, Stuck: state.IsStuck(now, h.stuckAfter)}
+	}
+
+	return views, nil
+}
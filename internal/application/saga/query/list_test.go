@@ -0,0 +1,69 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/saga"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleListSagasQuerySuccess(t *testing.T) {
+	// assign
+	stuckState := saga.NewState("test.saga", "1")
+	stuckState.ScheduleTimeout(time.Now().Add(-time.Hour))
+	runningState := saga.NewState("test.saga", "2")
+	runningState.ScheduleTimeout(time.Now().Add(time.Hour))
+
+	sagaRepositoryMock := new(persistence.SagaRepository)
+	sagaRepositoryMock.On("List", mock.Anything, saga.Filter{Status: saga.Running, Limit: 10}).
+		Return([]*saga.State{stuckState, runningState}, nil)
+
+	// act
+	listSagasQueryHandler := NewListSagasQueryHandler(sagaRepositoryMock, time.Minute)
+	views, err := listSagasQueryHandler.Handle(context.Background(), NewListSagasQuery("running", false, 10))
+
+	// assert
+	sagaRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, []SagaView{{State: stuckState, Stuck: true}, {State: runningState, Stuck: false}}, views)
+}
+
+func TestHandleListStuckSagasQueryFilter(t *testing.T) {
+	// assign
+	sagaRepositoryMock := new(persistence.SagaRepository)
+	sagaRepositoryMock.On("List", mock.Anything, mock.MatchedBy(func(filter saga.Filter) bool {
+		return filter.Status == saga.Running &&
+			filter.TimeoutBefore != nil &&
+			filter.TimeoutBefore.Before(time.Now().Add(-time.Minute))
+	})).Return(nil, nil)
+
+	// act
+	listSagasQueryHandler := NewListSagasQueryHandler(sagaRepositoryMock, time.Minute)
+	views, err := listSagasQueryHandler.Handle(context.Background(), NewListSagasQuery("", true, 10))
+
+	// assert
+	sagaRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Empty(t, views)
+}
+
+func TestListSagasQueryValidationError(t *testing.T) {
+	// assign
+	listSagasQuery := NewListSagasQuery("unknown", true, 0)
+
+	// act
+	err := listSagasQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Len(t, validationError.Errors, 3)
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+)
+
+const CancelScheduledCommandCommandType bus.CommandType = "cancel.scheduled_command.command"
+
+// CancelScheduledCommandCommand is the command dispatched to cancel scheduled command.
+type CancelScheduledCommandCommand struct {
+	id string
+}
+
+// NewCancelScheduledCommandCommand creates a new CancelScheduledCommandCommand.
+func NewCancelScheduledCommandCommand(ID string) CancelScheduledCommandCommand {
+	return CancelScheduledCommandCommand{
+		id: ID,
+	}
+}
+
+// Type implements bus.Command interface.
+func (c CancelScheduledCommandCommand) Type() bus.CommandType {
+	return CancelScheduledCommandCommandType
+}
+
+// Validate implements bus.Validatable interface.
+func (c CancelScheduledCommandCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := uuid.Parse(c.id); err != nil {
+		validationError.Add("id", ErrInvalidScheduledCommandID)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// CancelScheduledCommandCommandHandler is the CancelScheduledCommandCommand handler.
+type CancelScheduledCommandCommandHandler struct {
+	repository scheduler.Repository
+}
+
+// NewCancelScheduledCommandCommandHandler initializes a new CancelScheduledCommandCommandHandler.
+func NewCancelScheduledCommandCommandHandler(repository scheduler.Repository) CancelScheduledCommandCommandHandler {
+	return CancelScheduledCommandCommandHandler{
+		repository: repository,
+	}
+}
+
+// Handle implements the bus.TypedCommandHandler interface.
+func (h CancelScheduledCommandCommandHandler) Handle(
+	ctx context.Context,
+	cancelScheduledCommandCommand CancelScheduledCommandCommand,
+) error {
+	return h.repository.Cancel(ctx, cancelScheduledCommandCommand.id)
+}
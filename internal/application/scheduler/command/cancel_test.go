@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleCancelScheduledCommandCommandSuccess(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduledCommandRepositoryMock.On("Cancel", mock.Anything, id).Return(nil)
+
+	// act
+	cancelScheduledCommandCommandHandler := NewCancelScheduledCommandCommandHandler(scheduledCommandRepositoryMock)
+	err := cancelScheduledCommandCommandHandler.Handle(context.Background(), NewCancelScheduledCommandCommand(id))
+
+	// assert
+	scheduledCommandRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestHandleCancelScheduledCommandCommandNotScheduledError(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduledCommandRepositoryMock.On("Cancel", mock.Anything, id).Return(scheduler.ErrEntryNotScheduled)
+
+	// act
+	cancelScheduledCommandCommandHandler := NewCancelScheduledCommandCommandHandler(scheduledCommandRepositoryMock)
+	err := cancelScheduledCommandCommandHandler.Handle(context.Background(), NewCancelScheduledCommandCommand(id))
+
+	// assert
+	scheduledCommandRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, scheduler.ErrEntryNotScheduled)
+}
+
+func TestCancelScheduledCommandCommandValidationError(t *testing.T) {
+	// assign
+	cancelScheduledCommandCommand := NewCancelScheduledCommandCommand("invalid")
+
+	// act
+	err := cancelScheduledCommandCommand.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const ScheduleCommandCommandType bus.CommandType = "schedule.scheduled_command.command"
+
+var (
+	ErrInvalidScheduledCommandID = errors.New("invalid scheduled command id")
+	ErrScheduleTimeRequired      = errors.New("either run time or cron expression must be set")
+	ErrReservedActor             = errors.New("actors starting with \"" + aggregate.SystemActorPrefix + "\" are reserved for automated processes")
+)
+
+// ScheduleCommandCommand is the command dispatched to schedule another command given as type and JSON payload.
+type ScheduleCommandCommand struct {
+	id          string
+	commandType bus.CommandType
+	payload     json.RawMessage
+	runAt       time.Time
+	cron        string
+}
+
+// NewScheduleCommandCommand creates a new ScheduleCommandCommand. Exactly one of runAt and cron must be set.
+func NewScheduleCommandCommand(
+	ID string,
+	commandType string,
+	payload json.RawMessage,
+	runAt time.Time,
+	cron string,
+) ScheduleCommandCommand {
+	return ScheduleCommandCommand{
+		id:          ID,
+		commandType: bus.CommandType(commandType),
+		payload:     payload,
+		runAt:       runAt,
+		cron:        cron,
+	}
+}
+
+// Type implements bus.Command interface.
+func (c ScheduleCommandCommand) Type() bus.CommandType {
+	return ScheduleCommandCommandType
+}
+
+// Validate implements bus.Validatable interface.
+func (c ScheduleCommandCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := uuid.Parse(c.id); err != nil {
+		validationError.Add("id", ErrInvalidScheduledCommandID)
+	}
+
+	if c.commandType == "" {
+		validationError.Add("commandType", bus.ErrFieldRequired)
+	}
+
+	if c.runAt.IsZero() == (c.cron == "") {
+		validationError.Add("runAt", ErrScheduleTimeRequired)
+	}
+
+	if c.cron != "" {
+		if _, err := scheduler.ParseCron(c.cron); err != nil {
+			validationError.Add("cron", err)
+		}
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// ScheduleCommandCommandHandler is the ScheduleCommandCommand handler.
+type ScheduleCommandCommandHandler struct {
+	scheduler *scheduler.Scheduler
+	codec     *bus.CommandCodec
+}
+
+// NewScheduleCommandCommandHandler initializes a new ScheduleCommandCommandHandler.
+func NewScheduleCommandCommandHandler(scheduler *scheduler.Scheduler, codec *bus.CommandCodec) ScheduleCommandCommandHandler {
+	return ScheduleCommandCommandHandler{
+		scheduler: scheduler,
+		codec:     codec,
+	}
+}
+
+// Handle implements the bus.TypedCommandHandler interface.
+// Payload is decoded upfront, so unknown or malformed commands are never stored.
+// Payload is given by client, so it must not claim to be initiated by an automated process.
+func (h ScheduleCommandCommandHandler) Handle(ctx context.Context, scheduleCommandCommand ScheduleCommandCommand) error {
+	command, err := h.codec.Decode(scheduleCommandCommand.commandType, scheduleCommandCommand.payload)
+	if err != nil {
+		return err
+	}
+
+	if actorAware, ok := command.(bus.ActorAware); ok && aggregate.IsSystemActor(actorAware.Actor()) {
+		var validationError bus.ValidationError
+
+		validationError.Add("payload.actor", ErrReservedActor)
+
+		return validationError.ErrorOrNil()
+	}
+
+	if scheduleCommandCommand.cron != "" {
+		return h.scheduler.ScheduleRecurring(ctx, scheduleCommandCommand.id, command, scheduleCommandCommand.cron)
+	}
+
+	return h.scheduler.Schedule(ctx, scheduleCommandCommand.id, command, scheduleCommandCommand.runAt)
+}
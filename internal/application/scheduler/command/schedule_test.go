@@ -0,0 +1,153 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+type testCommand struct {
+	Value     string `json:"value"`
+	Initiator string `json:"actor"`
+}
+
+func (c testCommand) Type() bus.CommandType {
+	return "test.command"
+}
+
+func (c testCommand) Actor() string {
+	return c.Initiator
+}
+
+func newTestCodec() *bus.CommandCodec {
+	codec := bus.NewCommandCodec()
+	bus.RegisterCommandCodec[testCommand](codec)
+
+	return codec
+}
+
+func TestHandleScheduleCommandCommandSuccess(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+	runAt := time.Now().Add(time.Hour)
+
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduledCommandRepositoryMock.On("Add", mock.Anything, mock.MatchedBy(func(entry *scheduler.Entry) bool {
+		return entry.ID == id &&
+			entry.CommandType == "test.command" &&
+			string(entry.Payload) == `{"value":"test","actor":"agent@example.com"}` &&
+			entry.RunAt.Equal(runAt) &&
+			!entry.IsRecurring()
+	})).Return(nil)
+
+	scheduleCommandCommand := NewScheduleCommandCommand(
+		id,
+		"test.command",
+		[]byte(`{"value":"test","actor":"agent@example.com"}`),
+		runAt,
+		"",
+	)
+
+	// act
+	scheduleCommandCommandHandler := NewScheduleCommandCommandHandler(
+		scheduler.NewScheduler(scheduledCommandRepositoryMock, newTestCodec(), nil),
+		newTestCodec(),
+	)
+	err := scheduleCommandCommandHandler.Handle(context.Background(), scheduleCommandCommand)
+
+	// assert
+	scheduledCommandRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestHandleScheduleRecurringCommandCommandSuccess(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduledCommandRepositoryMock.On("Add", mock.Anything, mock.MatchedBy(func(entry *scheduler.Entry) bool {
+		return entry.ID == id && entry.Cron == "0 3 * * *" && entry.RunAt.After(time.Now())
+	})).Return(nil)
+
+	scheduleCommandCommand := NewScheduleCommandCommand(id, "test.command", []byte(`{"value":"test"}`), time.Time{}, "0 3 * * *")
+
+	// act
+	scheduleCommandCommandHandler := NewScheduleCommandCommandHandler(
+		scheduler.NewScheduler(scheduledCommandRepositoryMock, newTestCodec(), nil),
+		newTestCodec(),
+	)
+	err := scheduleCommandCommandHandler.Handle(context.Background(), scheduleCommandCommand)
+
+	// assert
+	scheduledCommandRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestHandleScheduleUnknownCommandError(t *testing.T) {
+	// assign
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduleCommandCommand := NewScheduleCommandCommand(uuid.New().String(), "unknown.command", []byte(`{}`), time.Now(), "")
+
+	// act
+	scheduleCommandCommandHandler := NewScheduleCommandCommandHandler(
+		scheduler.NewScheduler(scheduledCommandRepositoryMock, newTestCodec(), nil),
+		newTestCodec(),
+	)
+	err := scheduleCommandCommandHandler.Handle(context.Background(), scheduleCommandCommand)
+
+	// assert
+	scheduledCommandRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, bus.ErrUnknownCommandType)
+}
+
+func TestHandleScheduleCommandWithSystemActorError(t *testing.T) {
+	// assign
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduleCommandCommand := NewScheduleCommandCommand(
+		uuid.New().String(),
+		"test.command",
+		[]byte(`{"value":"test","actor":"system:expiry"}`),
+		time.Now().Add(time.Hour),
+		"",
+	)
+
+	// act
+	scheduleCommandCommandHandler := NewScheduleCommandCommandHandler(
+		scheduler.NewScheduler(scheduledCommandRepositoryMock, newTestCodec(), nil),
+		newTestCodec(),
+	)
+	err := scheduleCommandCommandHandler.Handle(context.Background(), scheduleCommandCommand)
+
+	// assert
+	scheduledCommandRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	if assert.ErrorAs(t, err, &validationError) && assert.Len(t, validationError.Errors, 1) {
+		assert.Equal(t, "payload.actor", validationError.Errors[0].Field)
+		assert.ErrorIs(t, validationError.Errors[0].Err, ErrReservedActor)
+	}
+}
+
+func TestScheduleCommandCommandValidationError(t *testing.T) {
+	// assign
+	scheduleCommandCommand := NewScheduleCommandCommand("invalid", "", nil, time.Now(), "* *")
+
+	// act
+	err := scheduleCommandCommand.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.Len(t, validationError.Errors, 4)
+	}
+}
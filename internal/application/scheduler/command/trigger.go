@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+)
+
+const TriggerScheduledCommandCommandType bus.CommandType = "trigger.scheduled_command.command"
+
+// TriggerScheduledCommandCommand is the command dispatched to run scheduled command as soon as possible.
+type TriggerScheduledCommandCommand struct {
+	id string
+}
+
+// NewTriggerScheduledCommandCommand creates a new TriggerScheduledCommandCommand.
+func NewTriggerScheduledCommandCommand(ID string) TriggerScheduledCommandCommand {
+	return TriggerScheduledCommandCommand{
+		id: ID,
+	}
+}
+
+// Type implements bus.Command interface.
+func (c TriggerScheduledCommandCommand) Type() bus.CommandType {
+	return TriggerScheduledCommandCommandType
+}
+
+// Validate implements bus.Validatable interface.
+func (c TriggerScheduledCommandCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := uuid.Parse(c.id); err != nil {
+		validationError.Add("id", ErrInvalidScheduledCommandID)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// TriggerScheduledCommandCommandHandler is the TriggerScheduledCommandCommand handler.
+// Command is not dispatched directly, it becomes due and is picked by the next scheduler poll.
+type TriggerScheduledCommandCommandHandler struct {
+	repository scheduler.Repository
+}
+
+// NewTriggerScheduledCommandCommandHandler initializes a new TriggerScheduledCommandCommandHandler.
+func NewTriggerScheduledCommandCommandHandler(repository scheduler.Repository) TriggerScheduledCommandCommandHandler {
+	return TriggerScheduledCommandCommandHandler{
+		repository: repository,
+	}
+}
+
+// Handle implements the bus.TypedCommandHandler interface.
+func (h TriggerScheduledCommandCommandHandler) Handle(
+	ctx context.Context,
+	triggerScheduledCommandCommand TriggerScheduledCommandCommand,
+) error {
+	return h.repository.Trigger(ctx, triggerScheduledCommandCommand.id, time.Now())
+}
@@ -0,0 +1,27 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleTriggerScheduledCommandCommandSuccess(t *testing.T) {
+	// assign
+	id := uuid.New().String()
+
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduledCommandRepositoryMock.On("Trigger", mock.Anything, id, mock.Anything).Return(nil)
+
+	// act
+	triggerScheduledCommandCommandHandler := NewTriggerScheduledCommandCommandHandler(scheduledCommandRepositoryMock)
+	err := triggerScheduledCommandCommandHandler.Handle(context.Background(), NewTriggerScheduledCommandCommand(id))
+
+	// assert
+	scheduledCommandRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+)
+
+const (
+	ListScheduledCommandsQueryType bus.QueryType = "list.scheduled_command.query"
+	listScheduledCommandsMaxLimit                = 1000
+)
+
+// ListScheduledCommandsQuery is the query dispatched to list scheduled commands.
+type ListScheduledCommandsQuery struct {
+	bus.Returns[[]*scheduler.Entry]
+	status string
+	limit  uint
+}
+
+// NewListScheduledCommandsQuery creates a new ListScheduledCommandsQuery. Empty status lists entries with any status.
+func NewListScheduledCommandsQuery(status string, limit uint) ListScheduledCommandsQuery {
+	return ListScheduledCommandsQuery{
+		status: status,
+		limit:  limit,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q ListScheduledCommandsQuery) Type() bus.QueryType {
+	return ListScheduledCommandsQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q ListScheduledCommandsQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if q.status != "" {
+		if _, err := scheduler.NewStatus(q.status); err != nil {
+			validationError.Add("status", err)
+		}
+	}
+
+	if q.limit == 0 || q.limit > listScheduledCommandsMaxLimit {
+		validationError.Addf("limit", bus.ErrFieldOutOfRange, "must be between 1 and %d", listScheduledCommandsMaxLimit)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// ListScheduledCommandsQueryHandler is the ListScheduledCommandsQuery handler.
+type ListScheduledCommandsQueryHandler struct {
+	repository scheduler.Repository
+}
+
+// NewListScheduledCommandsQueryHandler initializes a new ListScheduledCommandsQueryHandler.
+func NewListScheduledCommandsQueryHandler(repository scheduler.Repository) ListScheduledCommandsQueryHandler {
+	return ListScheduledCommandsQueryHandler{
+		repository: repository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h ListScheduledCommandsQueryHandler) Handle(
+	ctx context.Context,
+	listScheduledCommandsQuery ListScheduledCommandsQuery,
+) ([]*scheduler.Entry, error) {
+	return h.repository.List(ctx, scheduler.Filter{
+		Status: scheduler.Status(listScheduledCommandsQuery.status),
+		Limit:  listScheduledCommandsQuery.limit,
+	})
+}
@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleListScheduledCommandsQuerySuccess(t *testing.T) {
+	// assign
+	entry := scheduler.NewEntry("1", "test.command", []byte(`{}`), time.Now(), "")
+
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduledCommandRepositoryMock.On("List", mock.Anything, scheduler.Filter{Status: scheduler.Scheduled, Limit: 10}).
+		Return([]*scheduler.Entry{entry}, nil)
+
+	// act
+	listScheduledCommandsQueryHandler := NewListScheduledCommandsQueryHandler(scheduledCommandRepositoryMock)
+	entries, err := listScheduledCommandsQueryHandler.Handle(
+		context.Background(),
+		NewListScheduledCommandsQuery("scheduled", 10),
+	)
+
+	// assert
+	scheduledCommandRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, []*scheduler.Entry{entry}, entries)
+}
+
+func TestListScheduledCommandsQueryValidationError(t *testing.T) {
+	// assign
+	listScheduledCommandsQuery := NewListScheduledCommandsQuery("unknown", 0)
+
+	// act
+	err := listScheduledCommandsQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Len(t, validationError.Errors, 2)
+}
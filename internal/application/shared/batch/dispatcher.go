@@ -0,0 +1,119 @@
+package batch
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/transaction"
+)
+
+var ErrBatchRolledBack = errors.New("batch is rolled back because of another operation failure")
+
+// Status represents the result status of a single batch item.
+type Status string
+
+const (
+	Succeeded  Status = "succeeded"
+	Failed     Status = "failed"
+	RolledBack Status = "rolled_back"
+	Skipped    Status = "skipped"
+)
+
+// Result represents the result of a single batch item.
+type Result struct {
+	Status Status
+	Err    error
+}
+
+// Dispatcher dispatches several commands through bus.CommandBus one by one.
+type Dispatcher struct {
+	commandBus bus.CommandBus
+	unitOfWork transaction.UnitOfWork
+}
+
+// NewDispatcher creates a new Dispatcher.
+func NewDispatcher(commandBus bus.CommandBus, unitOfWork transaction.UnitOfWork) Dispatcher {
+	return Dispatcher{
+		commandBus: commandBus,
+		unitOfWork: unitOfWork,
+	}
+}
+
+// Dispatch dispatches commands independently, failure of one command does not affect the others.
+func (d Dispatcher) Dispatch(ctx context.Context, commands []bus.Command) []Result {
+	results := make([]Result, len(commands))
+
+	for i, command := range commands {
+		results[i] = toResult(d.commandBus.Dispatch(ctx, command))
+	}
+
+	return results
+}
+
+// DispatchAtomically dispatches all commands in a single transaction.
+// Commands are validated upfront, the first failure rolls back already dispatched commands and skips the rest.
+func (d Dispatcher) DispatchAtomically(ctx context.Context, commands []bus.Command) []Result {
+	results := make([]Result, len(commands))
+
+	for i, command := range commands {
+		if err := bus.Validate(command); err != nil {
+			return failAt(results, i, err, Skipped)
+		}
+	}
+
+	var failed = -1
+
+	err := d.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, command := range commands {
+			if err := d.commandBus.Dispatch(ctx, command); err != nil {
+				failed = i
+
+				return err
+			}
+
+			results[i] = Result{Status: Succeeded}
+		}
+
+		return nil
+	})
+
+	switch {
+	case err == nil:
+		return results
+	case failed >= 0:
+		return failAt(results, failed, err, RolledBack)
+	default:
+		// every command succeeded, but the transaction itself failed e.g. on commit
+		for i := range results {
+			results[i] = Result{Status: Failed, Err: err}
+		}
+
+		return results
+	}
+}
+
+// failAt marks item i as failed, items before it with given status and items after it as skipped.
+func failAt(results []Result, i int, err error, before Status) []Result {
+	for j := range results {
+		switch {
+		case j < i:
+			results[j] = Result{Status: before, Err: ErrBatchRolledBack}
+		case j == i:
+			results[j] = Result{Status: Failed, Err: err}
+		default:
+			results[j] = Result{Status: Skipped, Err: ErrBatchRolledBack}
+		}
+	}
+
+	return results
+}
+
+// toResult converts dispatch error to Result.
+func toResult(err error) Result {
+	if err != nil {
+		return Result{Status: Failed, Err: err}
+	}
+
+	return Result{Status: Succeeded}
+}
@@ -0,0 +1,143 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks"
+)
+
+var (
+	errTestCommandFailed = errors.New("test command failed")
+	errTestCommitFailed  = errors.New("test commit failed")
+)
+
+type testCommand struct {
+	name    string
+	invalid bool
+}
+
+func (c testCommand) Type() bus.CommandType {
+	return "test.command"
+}
+
+func (c testCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if c.invalid {
+		validationError.Add("name", bus.ErrFieldInvalid)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+type fakeUnitOfWork struct {
+	calls     int
+	commitErr error
+}
+
+func (u *fakeUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	u.calls++
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return u.commitErr
+}
+
+func statuses(results []Result) []Status {
+	var s []Status
+
+	for _, result := range results {
+		s = append(s, result.Status)
+	}
+
+	return s
+}
+
+func TestDispatchContinuesAfterFailure(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	unitOfWork := &fakeUnitOfWork{}
+	commands := []bus.Command{testCommand{name: "a"}, testCommand{name: "b"}, testCommand{name: "c"}}
+	commandBus.On("Dispatch", mock.Anything, commands[0]).Return(nil).Once()
+	commandBus.On("Dispatch", mock.Anything, commands[1]).Return(errTestCommandFailed).Once()
+	commandBus.On("Dispatch", mock.Anything, commands[2]).Return(nil).Once()
+
+	// act
+	results := NewDispatcher(commandBus, unitOfWork).Dispatch(context.Background(), commands)
+
+	// assert
+	assert.Equal(t, []Status{Succeeded, Failed, Succeeded}, statuses(results))
+	assert.ErrorIs(t, results[1].Err, errTestCommandFailed)
+	assert.Equal(t, 0, unitOfWork.calls)
+}
+
+func TestDispatchAtomicallySuccess(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	unitOfWork := &fakeUnitOfWork{}
+	commands := []bus.Command{testCommand{name: "a"}, testCommand{name: "b"}}
+	commandBus.On("Dispatch", mock.Anything, commands[0]).Return(nil).Once()
+	commandBus.On("Dispatch", mock.Anything, commands[1]).Return(nil).Once()
+
+	// act
+	results := NewDispatcher(commandBus, unitOfWork).DispatchAtomically(context.Background(), commands)
+
+	// assert
+	assert.Equal(t, []Status{Succeeded, Succeeded}, statuses(results))
+	assert.Equal(t, 1, unitOfWork.calls)
+}
+
+func TestDispatchAtomicallyRollsBackOnFailure(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	unitOfWork := &fakeUnitOfWork{}
+	commands := []bus.Command{testCommand{name: "a"}, testCommand{name: "b"}, testCommand{name: "c"}}
+	commandBus.On("Dispatch", mock.Anything, commands[0]).Return(nil).Once()
+	commandBus.On("Dispatch", mock.Anything, commands[1]).Return(errTestCommandFailed).Once()
+
+	// act
+	results := NewDispatcher(commandBus, unitOfWork).DispatchAtomically(context.Background(), commands)
+
+	// assert
+	assert.Equal(t, []Status{RolledBack, Failed, Skipped}, statuses(results))
+	assert.ErrorIs(t, results[1].Err, errTestCommandFailed)
+	commandBus.AssertNotCalled(t, "Dispatch", mock.Anything, commands[2])
+}
+
+func TestDispatchAtomicallyValidatesBeforeTransaction(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	unitOfWork := &fakeUnitOfWork{}
+	commands := []bus.Command{testCommand{name: "a"}, testCommand{name: "b", invalid: true}}
+
+	// act
+	results := NewDispatcher(commandBus, unitOfWork).DispatchAtomically(context.Background(), commands)
+
+	// assert
+	assert.Equal(t, []Status{Skipped, Failed}, statuses(results))
+	assert.ErrorIs(t, results[1].Err, bus.ErrValidationFailed)
+	commandBus.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	assert.Equal(t, 0, unitOfWork.calls)
+}
+
+func TestDispatchAtomicallyCommitFailure(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	unitOfWork := &fakeUnitOfWork{commitErr: errTestCommitFailed}
+	commands := []bus.Command{testCommand{name: "a"}, testCommand{name: "b"}}
+	commandBus.On("Dispatch", mock.Anything, mock.Anything).Return(nil).Twice()
+
+	// act
+	results := NewDispatcher(commandBus, unitOfWork).DispatchAtomically(context.Background(), commands)
+
+	// assert
+	assert.Equal(t, []Status{Failed, Failed}, statuses(results))
+	assert.ErrorIs(t, results[0].Err, errTestCommitFailed)
+}
@@ -0,0 +1,7 @@
+package bus
+
+// ActorAware defines interface for commands recording their initiator, e.g. support agent email.
+// It lets commands decoded from client payloads be checked for actors reserved to the service itself.
+type ActorAware interface {
+	Actor() string
+}
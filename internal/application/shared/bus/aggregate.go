@@ -0,0 +1,7 @@
+package bus
+
+// AggregateAware defines interface for commands and queries related to a single aggregate e.g. verification.
+// Bus decorators use aggregate identifier to relate commands with queries, e.g. to invalidate cached query results.
+type AggregateAware interface {
+	AggregateID() string
+}
@@ -0,0 +1,86 @@
+package bus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrUnknownCommandType = errors.New("unknown command type")
+	ErrCommandCodecFailed = errors.New("command encoding failed")
+)
+
+// CommandCodec serializes commands to JSON payload and restores them by command type,
+// so commands can be stored and dispatched later. Commands with unexported fields must implement
+// json.Marshaler and json.Unmarshaler.
+type CommandCodec struct {
+	mu       sync.RWMutex
+	decoders map[CommandType]func(payload []byte) (Command, error)
+}
+
+// NewCommandCodec creates a new CommandCodec without registered commands.
+func NewCommandCodec() *CommandCodec {
+	return &CommandCodec{decoders: make(map[CommandType]func(payload []byte) (Command, error))}
+}
+
+// RegisterCommandCodec makes command of type C decodable by CommandCodec.
+func RegisterCommandCodec[C Command](codec *CommandCodec) {
+	var command C
+
+	codec.mu.Lock()
+	defer codec.mu.Unlock()
+
+	codec.decoders[command.Type()] = func(payload []byte) (Command, error) {
+		var decoded C
+
+		if err := json.Unmarshal(payload, &decoded); err != nil {
+			return nil, err
+		}
+
+		return decoded, nil
+	}
+}
+
+// Encode serializes command. Only registered commands are encoded, so they can be decoded later.
+func (c *CommandCodec) Encode(command Command) ([]byte, error) {
+	if !c.Supports(command.Type()) {
+		return nil, fmt.Errorf("%s: %w", command.Type(), ErrUnknownCommandType)
+	}
+
+	payload, err := json.Marshal(command)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", ErrCommandCodecFailed, command.Type(), err)
+	}
+
+	return payload, nil
+}
+
+// Decode restores command of specific type from payload.
+func (c *CommandCodec) Decode(commandType CommandType, payload []byte) (Command, error) {
+	c.mu.RLock()
+	decode, ok := c.decoders[commandType]
+	c.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", commandType, ErrUnknownCommandType)
+	}
+
+	command, err := decode(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", ErrCommandCodecFailed, commandType, err)
+	}
+
+	return command, nil
+}
+
+// Supports reports whether command type is registered.
+func (c *CommandCodec) Supports(commandType CommandType) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.decoders[commandType]
+
+	return ok
+}
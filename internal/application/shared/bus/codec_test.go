@@ -0,0 +1,58 @@
+package bus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const payloadCommandType CommandType = "payload.test.command"
+
+type payloadCommand struct {
+	Value string `json:"value"`
+}
+
+func (c payloadCommand) Type() CommandType {
+	return payloadCommandType
+}
+
+func TestCommandCodecRoundTrip(t *testing.T) {
+	// assign
+	codec := NewCommandCodec()
+	RegisterCommandCodec[payloadCommand](codec)
+
+	// act
+	payload, encodeErr := codec.Encode(payloadCommand{Value: "value"})
+	command, decodeErr := codec.Decode(payloadCommandType, payload)
+
+	// assert
+	require.NoError(t, encodeErr)
+	require.NoError(t, decodeErr)
+	assert.Equal(t, payloadCommand{Value: "value"}, command)
+}
+
+func TestCommandCodecUnknownCommandTypeError(t *testing.T) {
+	// assign
+	codec := NewCommandCodec()
+
+	// act
+	_, encodeErr := codec.Encode(testCommand{})
+	_, decodeErr := codec.Decode(testCommandType, []byte("{}"))
+
+	// assert
+	assert.ErrorIs(t, encodeErr, ErrUnknownCommandType)
+	assert.ErrorIs(t, decodeErr, ErrUnknownCommandType)
+}
+
+func TestCommandCodecInvalidPayloadError(t *testing.T) {
+	// assign
+	codec := NewCommandCodec()
+	RegisterCommandCodec[payloadCommand](codec)
+
+	// act
+	_, err := codec.Decode(payloadCommandType, []byte("not json"))
+
+	// assert
+	assert.Contains(t, err.Error(), ErrCommandCodecFailed.Error())
+}
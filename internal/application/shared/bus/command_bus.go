@@ -10,12 +10,15 @@ var (
 	ErrCommandHandlerNotFound = errors.New("command handler not found")
 )
 
-// CommandBus defines interface for CQRS command bus implementations e.g. RabbitMQCommandBus, ApacheKafkaCommandBus.
+// CommandBus defines interface for CQRS command bus implementations e.g. in-memory or durable queue-backed ones.
 type CommandBus interface {
 	Dispatch(context.Context, Command) error
-	Register(CommandType, CommandHandler)
+	// Register fails with ErrHandlerAlreadyRegistered if command type already has a handler.
+	Register(CommandType, CommandHandler) error
 }
 
+//go:generate mockery --case=snake --outpkg=mocks --output=test/mocks --name=CommandBus
+
 // CommandType is a unique string needed to identity command in CommandBus.
 type CommandType string
 
@@ -0,0 +1,88 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrCommandQueueFull = errors.New("command queue is full")
+	ErrCommandBusClosed = errors.New("command bus is closed")
+)
+
+// AsyncCommandBus defines interface for command bus implementations which handle commands in background.
+type AsyncCommandBus interface {
+	CommandBus
+	Enqueue(context.Context, Command) (string, error)
+}
+
+// JobStatus represents the status of the command handled in background.
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+)
+
+// Job is the data structure that represents command handled in background.
+// Command handlers return nothing but error, so Result of succeeded job is the identifier of the aggregate
+// changed by command, e.g. verification uuid, and stays empty for commands not related to a single aggregate.
+type Job struct {
+	ID          string
+	CommandType CommandType
+	Status      JobStatus
+	Result      string
+	Error       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// JobRepository defines the expected behaviour for a job storage.
+type JobRepository interface {
+	Add(ctx context.Context, job *Job) error
+	Update(ctx context.Context, job *Job) error
+	GetByID(ctx context.Context, id string) (*Job, error)
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=JobRepository
+
+// NewJob creates a new pending Job.
+func NewJob(id string, commandType CommandType) *Job {
+	now := time.Now()
+
+	return &Job{
+		ID:          id,
+		CommandType: commandType,
+		Status:      JobPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Start changes Job status to running.
+func (j *Job) Start() {
+	j.Status = JobRunning
+	j.UpdatedAt = time.Now()
+}
+
+// Succeed changes Job status to succeeded and stores the result of handled command.
+func (j *Job) Succeed(command Command) {
+	j.Status = JobSucceeded
+	j.Result = ""
+
+	if aggregateAware, ok := command.(AggregateAware); ok {
+		j.Result = aggregateAware.AggregateID()
+	}
+
+	j.UpdatedAt = time.Now()
+}
+
+// Fail changes Job status to failed and stores the failure reason.
+func (j *Job) Fail(err error) {
+	j.Status = JobFailed
+	j.Error = err.Error()
+	j.UpdatedAt = time.Now()
+}
@@ -13,7 +13,8 @@ var (
 // QueryBus defines interface for CQRS query bus implementations.
 type QueryBus interface {
 	Ask(context.Context, Query) (any, error)
-	Register(QueryType, QueryHandler)
+	// Register fails with ErrHandlerAlreadyRegistered if query type already has a handler.
+	Register(QueryType, QueryHandler) error
 }
 
 // QueryType is a unique string needed to identity query in QueryBus.
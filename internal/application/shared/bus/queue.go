@@ -0,0 +1,59 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrCommandDeliveriesExhausted = errors.New("command delivery attempts exhausted")
+	ErrCommandLeaseLost           = errors.New("queued command lease lost")
+)
+
+// QueuedCommand represents encoded command stored in durable CommandQueue until it is handled.
+// Commands with the same OrderingKey are handled one by one in the enqueue order.
+type QueuedCommand struct {
+	ID          string
+	CommandType CommandType
+	Payload     []byte
+	OrderingKey string
+	Deliveries  uint
+	CreatedAt   time.Time
+}
+
+// NewQueuedCommand creates a new QueuedCommand. Aggregate commands are ordered by their aggregate identifier.
+func NewQueuedCommand(id string, command Command, payload []byte) *QueuedCommand {
+	var orderingKey string
+
+	if aggregateCommand, ok := command.(AggregateAware); ok {
+		orderingKey = aggregateCommand.AggregateID()
+	}
+
+	return &QueuedCommand{
+		ID:          id,
+		CommandType: command.Type(),
+		Payload:     payload,
+		OrderingKey: orderingKey,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// CommandQueue defines durable storage of commands waiting to be handled by workers.
+// Delivery is at-least-once: command claimed by a worker which died before Ack is delivered again
+// after visibility timeout, so handlers must tolerate repeated commands. Claim is identified by command deliveries,
+// so a worker whose lease expired and was claimed by another one can neither extend nor acknowledge it.
+type CommandQueue interface {
+	// Enqueue stores command. It joins transaction of ctx, so command is enqueued only if transaction commits.
+	Enqueue(ctx context.Context, command *QueuedCommand) error
+	// Claim hides the earliest available command from other workers until now+visibilityTimeout and increments
+	// its deliveries. Command is skipped while earlier command with the same ordering key is not acknowledged.
+	// It reports false if no command is available.
+	Claim(ctx context.Context, now time.Time, visibilityTimeout time.Duration) (*QueuedCommand, bool, error)
+	// Extend hides command claimed by Claim from other workers until lockedUntil, so command handled longer than
+	// visibility timeout is not delivered again meanwhile. It fails with ErrCommandLeaseLost if command was
+	// acknowledged or claimed again since.
+	Extend(ctx context.Context, command *QueuedCommand, lockedUntil time.Time) error
+	// Ack removes handled command from queue. It fails with ErrCommandLeaseLost if command was claimed again since.
+	Ack(ctx context.Context, command *QueuedCommand) error
+}
@@ -0,0 +1,30 @@
+package bus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type aggregateCommand struct {
+	id string
+}
+
+func (c aggregateCommand) Type() CommandType {
+	return "aggregate.command"
+}
+
+func (c aggregateCommand) AggregateID() string {
+	return c.id
+}
+
+func TestNewQueuedCommandOrderingKey(t *testing.T) {
+	// act
+	aggregateQueued := NewQueuedCommand("1", aggregateCommand{id: "8e03978e-40d5-43e8-bc93-6894a57f9324"}, []byte(`{}`))
+	plainQueued := NewQueuedCommand("2", testCommand{}, []byte(`{}`))
+
+	// assert
+	assert.Equal(t, "8e03978e-40d5-43e8-bc93-6894a57f9324", aggregateQueued.OrderingKey)
+	assert.Equal(t, CommandType("aggregate.command"), aggregateQueued.CommandType)
+	assert.Empty(t, plainQueued.OrderingKey)
+}
@@ -0,0 +1,79 @@
+package bus
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrHandlerAlreadyRegistered = errors.New("handler already registered")
+	ErrHandlersMissing          = errors.New("handlers are not registered")
+)
+
+// HandlerInfo describes handler registered in bus for command or query type.
+type HandlerInfo struct {
+	Type    string
+	Handler string
+}
+
+// HandlerRegistry is implemented by buses able to list their registered handlers.
+type HandlerRegistry interface {
+	// Handlers returns registered handlers sorted by type.
+	Handlers() []HandlerInfo
+}
+
+// namedHandler is implemented by handler adapters to expose the name of the wrapped handler.
+type namedHandler interface {
+	handlerName() string
+}
+
+// HandlerName returns human-readable handler name, e.g. "command.CreateVerificationCommandHandler".
+func HandlerName(handler any) string {
+	if named, ok := handler.(namedHandler); ok {
+		return named.handlerName()
+	}
+
+	return fmt.Sprintf("%T", handler)
+}
+
+// SortHandlers sorts handlers by type, so registries return them in a stable order.
+func SortHandlers(handlers []HandlerInfo) {
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].Type < handlers[j].Type
+	})
+}
+
+// CheckCommandHandlers fails with ErrHandlersMissing if any of commandTypes has no handler in registry.
+func CheckCommandHandlers(registry HandlerRegistry, commandTypes ...CommandType) error {
+	return checkHandlers(registry, commandTypes)
+}
+
+// CheckQueryHandlers fails with ErrHandlersMissing if any of queryTypes has no handler in registry.
+func CheckQueryHandlers(registry HandlerRegistry, queryTypes ...QueryType) error {
+	return checkHandlers(registry, queryTypes)
+}
+
+// checkHandlers collects all types without handler in registry.
+func checkHandlers[T ~string](registry HandlerRegistry, types []T) error {
+	registered := make(map[string]struct{})
+
+	for _, handler := range registry.Handlers() {
+		registered[handler.Type] = struct{}{}
+	}
+
+	var missing []string
+
+	for _, handlerType := range types {
+		if _, ok := registered[string(handlerType)]; !ok {
+			missing = append(missing, string(handlerType))
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrHandlersMissing, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
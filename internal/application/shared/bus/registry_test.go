@@ -0,0 +1,49 @@
+package bus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticRegistry []HandlerInfo
+
+func (r staticRegistry) Handlers() []HandlerInfo {
+	return r
+}
+
+func TestHandlerNameOfTypedHandler(t *testing.T) {
+	// assign
+	var handled bool
+
+	commandHandler := NewCommandHandler[testCommand](testCommandHandler{handled: &handled})
+
+	// act
+	name := HandlerName(commandHandler)
+
+	// assert
+	assert.Equal(t, "bus.testCommandHandler", name)
+}
+
+func TestCheckCommandHandlersSuccess(t *testing.T) {
+	// assign
+	registry := staticRegistry{{Type: string(testCommandType), Handler: "bus.testCommandHandler"}}
+
+	// act
+	err := CheckCommandHandlers(registry, testCommandType)
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestCheckQueryHandlersMissingError(t *testing.T) {
+	// assign
+	registry := staticRegistry{{Type: string(testQueryType), Handler: "bus.testQueryHandler"}}
+
+	// act
+	err := CheckQueryHandlers(registry, testQueryType, "missing.query", "another_missing.query")
+
+	// assert
+	assert.ErrorIs(t, err, ErrHandlersMissing)
+	assert.Contains(t, err.Error(), "missing.query, another_missing.query")
+}
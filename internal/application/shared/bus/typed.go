@@ -0,0 +1,160 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var ErrUnexpectedQueryResult = errors.New("unexpected query result")
+
+// Returns is embedded into query to declare the type of its result.
+// NewQueryHandler and Ask verify that handler and caller agree with the declared type.
+type Returns[R any] struct{}
+
+// resultType implements resultDeclarer interface.
+func (Returns[R]) resultType() reflect.Type {
+	return reflect.TypeOf((*R)(nil)).Elem()
+}
+
+// resultDeclarer is implemented by queries embedding Returns.
+type resultDeclarer interface {
+	resultType() reflect.Type
+}
+
+// TypedCommandHandler defines handler of the concrete command type C.
+type TypedCommandHandler[C Command] interface {
+	Handle(context.Context, C) error
+}
+
+// TypedQueryHandler defines handler of the concrete query type Q returning result of type R.
+type TypedQueryHandler[Q Query, R any] interface {
+	Handle(context.Context, Q) (R, error)
+}
+
+// commandHandlerAdapter adapts TypedCommandHandler to CommandHandler interface.
+type commandHandlerAdapter[C Command] struct {
+	handler TypedCommandHandler[C]
+}
+
+// Handle implements the CommandHandler interface.
+func (a commandHandlerAdapter[C]) Handle(ctx context.Context, cmd Command) error {
+	typedCommand, ok := cmd.(C)
+	if !ok {
+		return fmt.Errorf("command type %s: %w", cmd.Type(), ErrUnexpectedCommand)
+	}
+
+	return a.handler.Handle(ctx, typedCommand)
+}
+
+// handlerName implements namedHandler interface.
+func (a commandHandlerAdapter[C]) handlerName() string {
+	return HandlerName(a.handler)
+}
+
+// queryHandlerAdapter adapts TypedQueryHandler to QueryHandler interface.
+type queryHandlerAdapter[Q Query, R any] struct {
+	handler TypedQueryHandler[Q, R]
+}
+
+// Handle implements the QueryHandler interface.
+func (a queryHandlerAdapter[Q, R]) Handle(ctx context.Context, q Query) (any, error) {
+	typedQuery, ok := q.(Q)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", q.Type(), ErrUnexpectedQuery)
+	}
+
+	result, err := a.handler.Handle(ctx, typedQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// handlerName implements namedHandler interface.
+func (a queryHandlerAdapter[Q, R]) handlerName() string {
+	return HandlerName(a.handler)
+}
+
+// NewCommandHandler adapts TypedCommandHandler to CommandHandler interface.
+func NewCommandHandler[C Command](handler TypedCommandHandler[C]) CommandHandler {
+	return commandHandlerAdapter[C]{handler: handler}
+}
+
+// NewQueryHandler adapts TypedQueryHandler to QueryHandler interface.
+// It fails if Q declares result type with Returns different from R.
+func NewQueryHandler[Q Query, R any](handler TypedQueryHandler[Q, R]) (QueryHandler, error) {
+	var query Q
+
+	if err := checkResultType[R](query); err != nil {
+		return nil, err
+	}
+
+	return queryHandlerAdapter[Q, R]{handler: handler}, nil
+}
+
+// RegisterCommandHandler registers TypedCommandHandler in CommandBus under the type of C.
+// It fails if the type of C already has a handler.
+func RegisterCommandHandler[C Command](b CommandBus, handler TypedCommandHandler[C]) error {
+	var command C
+
+	return b.Register(command.Type(), NewCommandHandler[C](handler))
+}
+
+// RegisterQueryHandler registers TypedQueryHandler in QueryBus under the type of Q.
+// It fails if Q declares result type with Returns different from R or the type of Q already has a handler.
+func RegisterQueryHandler[Q Query, R any](b QueryBus, handler TypedQueryHandler[Q, R]) error {
+	var query Q
+
+	queryHandler, err := NewQueryHandler[Q, R](handler)
+	if err != nil {
+		return err
+	}
+
+	return b.Register(query.Type(), queryHandler)
+}
+
+// Ask asks QueryBus and returns query result as R instead of any.
+func Ask[Q Query, R any](ctx context.Context, b QueryBus, query Q) (R, error) {
+	var zero R
+
+	if err := checkResultType[R](query); err != nil {
+		return zero, err
+	}
+
+	result, err := b.Ask(ctx, query)
+	if err != nil {
+		return zero, err
+	}
+
+	typedResult, ok := result.(R)
+	if !ok {
+		return zero, fmt.Errorf("query type %s result %T: %w", query.Type(), result, ErrUnexpectedQueryResult)
+	}
+
+	return typedResult, nil
+}
+
+// checkResultType verifies that R matches result type declared by query with Returns.
+func checkResultType[R any](query Query) error {
+	declarer, ok := query.(resultDeclarer)
+	if !ok {
+		return nil
+	}
+
+	expected := reflect.TypeOf((*R)(nil)).Elem()
+
+	if declarer.resultType() != expected {
+		return fmt.Errorf(
+			"query type %s declares result %s, got %s: %w",
+			query.Type(),
+			declarer.resultType(),
+			expected,
+			ErrUnexpectedQueryResult,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package bus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCommandType CommandType = "test.command"
+	testQueryType   QueryType   = "test.query"
+)
+
+type testCommand struct{}
+
+func (c testCommand) Type() CommandType {
+	return testCommandType
+}
+
+type testQuery struct {
+	Returns[string]
+}
+
+func (q testQuery) Type() QueryType {
+	return testQueryType
+}
+
+type anotherQuery struct{}
+
+func (q anotherQuery) Type() QueryType {
+	return testQueryType
+}
+
+type testCommandHandler struct {
+	handled *bool
+}
+
+func (h testCommandHandler) Handle(_ context.Context, _ testCommand) error {
+	*h.handled = true
+
+	return nil
+}
+
+type testQueryHandler struct{}
+
+func (h testQueryHandler) Handle(_ context.Context, _ testQuery) (string, error) {
+	return "result", nil
+}
+
+type anotherQueryHandler struct{}
+
+func (h anotherQueryHandler) Handle(_ context.Context, _ anotherQuery) (int, error) {
+	return 1, nil
+}
+
+type intQueryHandler struct{}
+
+func (h intQueryHandler) Handle(_ context.Context, _ testQuery) (int, error) {
+	return 1, nil
+}
+
+type mapCommandBus map[CommandType]CommandHandler
+
+func (b mapCommandBus) Dispatch(ctx context.Context, command Command) error {
+	return b[command.Type()].Handle(ctx, command)
+}
+
+func (b mapCommandBus) Register(commandType CommandType, handler CommandHandler) error {
+	b[commandType] = handler
+
+	return nil
+}
+
+type mapQueryBus map[QueryType]QueryHandler
+
+func (b mapQueryBus) Ask(ctx context.Context, query Query) (any, error) {
+	return b[query.Type()].Handle(ctx, query)
+}
+
+func (b mapQueryBus) Register(queryType QueryType, handler QueryHandler) error {
+	b[queryType] = handler
+
+	return nil
+}
+
+func TestRegisterCommandHandlerSuccess(t *testing.T) {
+	// assign
+	var handled bool
+
+	commandBus := make(mapCommandBus)
+
+	// act
+	require.NoError(t, RegisterCommandHandler[testCommand](commandBus, testCommandHandler{handled: &handled}))
+	err := commandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, handled)
+}
+
+func TestCommandHandlerUnexpectedCommandError(t *testing.T) {
+	// assign
+	var handled bool
+
+	commandHandler := NewCommandHandler[testCommand](testCommandHandler{handled: &handled})
+
+	// act
+	err := commandHandler.Handle(context.Background(), unexpectedCommand{})
+
+	// assert
+	assert.ErrorIs(t, err, ErrUnexpectedCommand)
+	assert.False(t, handled)
+}
+
+func TestRegisterQueryHandlerAndAskSuccess(t *testing.T) {
+	// assign
+	queryBus := make(mapQueryBus)
+
+	// act
+	err := RegisterQueryHandler[testQuery, string](queryBus, testQueryHandler{})
+	require.NoError(t, err)
+	result, err := Ask[testQuery, string](context.Background(), queryBus, testQuery{})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "result", result)
+}
+
+func TestRegisterQueryHandlerResultTypeMismatchError(t *testing.T) {
+	// assign
+	queryBus := make(mapQueryBus)
+
+	// act
+	err := RegisterQueryHandler[testQuery, int](queryBus, intQueryHandler{})
+
+	// assert
+	assert.ErrorIs(t, err, ErrUnexpectedQueryResult)
+	assert.Empty(t, queryBus)
+}
+
+func TestAskResultTypeMismatchError(t *testing.T) {
+	// assign
+	queryBus := make(mapQueryBus)
+	require.NoError(t, queryBus.Register(testQueryType, queryHandlerAdapter[anotherQuery, int]{handler: anotherQueryHandler{}}))
+
+	// act
+	declaredResult, declaredErr := Ask[testQuery, int](context.Background(), queryBus, testQuery{})
+	actualResult, actualErr := Ask[anotherQuery, string](context.Background(), queryBus, anotherQuery{})
+
+	// assert
+	assert.ErrorIs(t, declaredErr, ErrUnexpectedQueryResult)
+	assert.Zero(t, declaredResult)
+	assert.ErrorIs(t, actualErr, ErrUnexpectedQueryResult)
+	assert.Zero(t, actualResult)
+}
+
+type unexpectedCommand struct{}
+
+func (c unexpectedCommand) Type() CommandType {
+	return "unexpected.command"
+}
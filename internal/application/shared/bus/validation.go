@@ -0,0 +1,83 @@
+package bus
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrValidationFailed = errors.New("validation failed")
+
+// Generic invalid field errors. Fields with domain rules are reported with domain errors instead,
+// so clients get the stable code of the specific rule.
+var (
+	ErrFieldRequired   = errors.New("must not be empty")
+	ErrFieldOutOfRange = errors.New("value is out of range")
+	ErrFieldTooShort   = errors.New("value is too short")
+	ErrFieldTooLong    = errors.New("value is too long")
+	ErrFieldInvalid    = errors.New("invalid value")
+)
+
+// Validatable defines optional interface for commands and queries validating themselves.
+// Buses call Validate before handler is invoked, so every caller gets the same validation as HTTP clients.
+type Validatable interface {
+	Validate() error
+}
+
+// FieldError represents single invalid command or query field.
+// Err identifies the broken rule and is matched with errors.Is, Message may describe it in more detail.
+type FieldError struct {
+	Field   string
+	Message string
+	Err     error
+}
+
+// ValidationError represents structured validation errors of command or query.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// Add appends field invalid because of err to ValidationError.
+func (e *ValidationError) Add(field string, err error) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Message: err.Error(), Err: err})
+}
+
+// Addf appends field invalid because of err with formatted message, e.g. the allowed range.
+func (e *ValidationError) Addf(field string, err error, format string, args ...any) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...), Err: err})
+}
+
+// ErrorOrNil returns ValidationError if at least one field is invalid, nil otherwise.
+func (e *ValidationError) ErrorOrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Error implements error interface.
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+
+	for _, fieldError := range e.Errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", fieldError.Field, fieldError.Message))
+	}
+
+	return fmt.Sprintf("%s: %s", ErrValidationFailed, strings.Join(messages, "; "))
+}
+
+// Unwrap allows to match ValidationError with errors.Is(err, ErrValidationFailed).
+func (e *ValidationError) Unwrap() error {
+	return ErrValidationFailed
+}
+
+// Validate validates command or query if it implements Validatable interface.
+func Validate(message any) error {
+	validatable, ok := message.(Validatable)
+	if !ok {
+		return nil
+	}
+
+	return validatable.Validate()
+}
@@ -0,0 +1,46 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+var ErrLetterNotFound = errors.New("dead letter not found")
+
+// Letter represents encoded command which failed with retryable error after all attempts of its retry policy.
+type Letter struct {
+	ID          string
+	CommandType bus.CommandType
+	Payload     []byte
+	Error       string
+	Attempts    uint
+	CreatedAt   time.Time
+}
+
+// NewLetter creates a new Letter.
+func NewLetter(id string, commandType bus.CommandType, payload []byte, err error, attempts uint) *Letter {
+	return &Letter{
+		ID:          id,
+		CommandType: commandType,
+		Payload:     payload,
+		Error:       err.Error(),
+		Attempts:    attempts,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// Repository defines dead letters persistence.
+type Repository interface {
+	Add(ctx context.Context, letter *Letter) error
+	// Get returns letter by id. It fails with ErrLetterNotFound if letter does not exist.
+	Get(ctx context.Context, id string) (*Letter, error)
+	// List returns at most limit letters starting from the newest one.
+	List(ctx context.Context, limit uint) ([]*Letter, error)
+	// Delete removes letter by id. It fails with ErrLetterNotFound if letter does not exist.
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=Repository --structname=DeadLetterRepository --filename=dead_letter_repository.go
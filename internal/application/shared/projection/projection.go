@@ -0,0 +1,14 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+// Projection defines read model maintained after every successfully handled command.
+// Project is called in the same transaction.UnitOfWork as the command handler,
+// so read model never diverges from the write side. Projection must ignore commands it is not interested in.
+type Projection interface {
+	Project(ctx context.Context, command bus.Command) error
+}
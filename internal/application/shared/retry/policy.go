@@ -0,0 +1,62 @@
+package retry
+
+import (
+	"math"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+// Policy describes how failed command is retried.
+// Backoff before attempt n+1 grows as InitialBackoff * Multiplier^(n-1) up to MaxBackoff,
+// then it is randomly spread by Jitter fraction, so concurrently failed commands do not retry at once.
+type Policy struct {
+	MaxAttempts    uint
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64
+	// Retryable reports whether error is transient, e.g. lost database connection. Other errors are never retried.
+	Retryable func(err error) bool
+}
+
+// IsRetryable reports whether command failed with err may be attempted again. Policy without classifier retries nothing.
+func (p Policy) IsRetryable(err error) bool {
+	return p.Retryable != nil && p.Retryable(err)
+}
+
+// Backoff returns delay before the next attempt after failed attempt. Random must be in [0, 1) range.
+func (p Policy) Backoff(attempt uint, random float64) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+
+	if maxBackoff := float64(p.MaxBackoff); p.MaxBackoff > 0 && backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	backoff *= 1 - p.Jitter + 2*p.Jitter*random
+
+	return time.Duration(backoff)
+}
+
+// Policies holds retry Policy of every command type falling back to the default one.
+type Policies struct {
+	defaultPolicy Policy
+	policies      map[bus.CommandType]Policy
+}
+
+// NewPolicies creates a new Policies.
+func NewPolicies(defaultPolicy Policy, policies map[bus.CommandType]Policy) Policies {
+	return Policies{
+		defaultPolicy: defaultPolicy,
+		policies:      policies,
+	}
+}
+
+// For returns retry Policy of command type.
+func (p Policies) For(commandType bus.CommandType) Policy {
+	if policy, ok := p.policies[commandType]; ok {
+		return policy
+	}
+
+	return p.defaultPolicy
+}
@@ -0,0 +1,84 @@
+package retry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+var errTransient = errors.New("transient error")
+
+func testPolicy() Policy {
+	return Policy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		Jitter:         0.5,
+		Retryable: func(err error) bool {
+			return errors.Is(err, errTransient)
+		},
+	}
+}
+
+func TestPolicyBackoffGrowsExponentiallyUpToMax(t *testing.T) {
+	// assign
+	policy := testPolicy()
+
+	// act
+	first := policy.Backoff(1, 0.5)
+	second := policy.Backoff(2, 0.5)
+	capped := policy.Backoff(10, 0.5)
+
+	// assert
+	assert.Equal(t, 100*time.Millisecond, first)
+	assert.Equal(t, 200*time.Millisecond, second)
+	assert.Equal(t, time.Second, capped)
+}
+
+func TestPolicyBackoffJitter(t *testing.T) {
+	// assign
+	policy := testPolicy()
+
+	// act
+	lowest := policy.Backoff(1, 0)
+	highest := policy.Backoff(1, 0.999)
+
+	// assert
+	assert.Equal(t, 50*time.Millisecond, lowest)
+	assert.InDelta(t, float64(150*time.Millisecond), float64(highest), float64(time.Millisecond))
+}
+
+func TestPolicyIsRetryable(t *testing.T) {
+	// assign
+	policy := testPolicy()
+
+	// act
+	transient := policy.IsRetryable(errTransient)
+	permanent := policy.IsRetryable(errors.New("verification is already processed"))
+	unclassified := Policy{MaxAttempts: 3}.IsRetryable(errTransient)
+
+	// assert
+	assert.True(t, transient)
+	assert.False(t, permanent)
+	assert.False(t, unclassified)
+}
+
+func TestPoliciesFallBackToDefault(t *testing.T) {
+	// assign
+	approvePolicy := testPolicy()
+	approvePolicy.MaxAttempts = 5
+
+	policies := NewPolicies(testPolicy(), map[bus.CommandType]Policy{"approve.verification.command": approvePolicy})
+
+	// act
+	approve := policies.For("approve.verification.command")
+	decline := policies.For("decline.verification.command")
+
+	// assert
+	assert.Equal(t, uint(5), approve.MaxAttempts)
+	assert.Equal(t, uint(3), decline.MaxAttempts)
+}
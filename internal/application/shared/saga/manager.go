@@ -0,0 +1,129 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/transaction"
+)
+
+// dueTimeoutsBatchSize limits the number of saga timeouts handled at once.
+const dueTimeoutsBatchSize = 100
+
+// Manager routes command outcomes and timeouts to registered sagas and dispatches their follow-up commands.
+type Manager struct {
+	repository Repository
+	unitOfWork transaction.UnitOfWork
+	sagas      map[Type]Saga
+}
+
+// NewManager creates a new Manager.
+func NewManager(repository Repository, unitOfWork transaction.UnitOfWork, sagas ...Saga) *Manager {
+	m := &Manager{
+		repository: repository,
+		unitOfWork: unitOfWork,
+		sagas:      make(map[Type]Saga, len(sagas)),
+	}
+
+	for _, saga := range sagas {
+		m.sagas[saga.Type()] = saga
+	}
+
+	return m
+}
+
+// Handle lets every saga react to the outcome of command. Only bus.AggregateAware commands are considered.
+// Follow-up commands are dispatched through commandBus after saga state is saved,
+// so concurrently updated saga never dispatches them twice.
+func (m *Manager) Handle(ctx context.Context, commandBus bus.CommandBus, command bus.Command, commandErr error) error {
+	aggregateAware, ok := command.(bus.AggregateAware)
+	if !ok {
+		return nil
+	}
+
+	var firstErr error
+
+	for _, saga := range m.sagas {
+		if err := m.handle(ctx, commandBus, saga, aggregateAware.AggregateID(), command, commandErr); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// HandleTimeouts lets sagas react to timeouts expired before now. Saga state is saved in one transaction
+// with its follow-up commands, so timeout stays due and is handled again if any of them fails.
+func (m *Manager) HandleTimeouts(ctx context.Context, commandBus bus.CommandBus, now time.Time) error {
+	states, err := m.repository.ListDue(ctx, now, dueTimeoutsBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+
+	for _, state := range states {
+		saga, ok := m.sagas[state.Type]
+		if !ok {
+			continue
+		}
+
+		err := m.unitOfWork.Do(ctx, func(ctx context.Context) error {
+			state.TimeoutAt = nil
+
+			return m.advance(ctx, commandBus, state, saga.Timeout(state))
+		})
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// handle loads saga state of aggregate or starts a new one and passes command outcome to saga.
+func (m *Manager) handle(
+	ctx context.Context,
+	commandBus bus.CommandBus,
+	saga Saga,
+	aggregateID string,
+	command bus.Command,
+	commandErr error,
+) error {
+	state, err := m.repository.Get(ctx, saga.Type(), aggregateID)
+
+	switch {
+	case errors.Is(err, ErrStateNotFound):
+		if commandErr != nil || !saga.Starts(command) {
+			return nil
+		}
+
+		state = NewState(saga.Type(), aggregateID)
+	case err != nil:
+		return err
+	case !state.IsRunning():
+		return nil
+	}
+
+	return m.advance(ctx, commandBus, state, saga.Handle(state, command, commandErr))
+}
+
+// advance saves changed saga state and dispatches follow-up commands.
+func (m *Manager) advance(ctx context.Context, commandBus bus.CommandBus, state *State, commands []bus.Command) error {
+	if err := m.repository.Save(ctx, state); err != nil {
+		return fmt.Errorf("saga %s of %s: %w", state.Type, state.AggregateID, err)
+	}
+
+	var firstErr error
+
+	for _, command := range commands {
+		if err := commandBus.Dispatch(ctx, command); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("saga %s of %s follow-up %s: %w", state.Type, state.AggregateID, command.Type(), err)
+		}
+	}
+
+	return firstErr
+}
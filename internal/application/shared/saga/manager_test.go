@@ -0,0 +1,254 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks"
+)
+
+const testSagaType Type = "test.saga"
+
+var errTestCommandFailed = errors.New("test command failed")
+
+type startCommand struct{ id string }
+
+func (c startCommand) Type() bus.CommandType { return "start.test.command" }
+func (c startCommand) AggregateID() string   { return c.id }
+
+type finishCommand struct{ id string }
+
+func (c finishCommand) Type() bus.CommandType { return "finish.test.command" }
+func (c finishCommand) AggregateID() string   { return c.id }
+
+type unrelatedCommand struct{}
+
+func (c unrelatedCommand) Type() bus.CommandType { return "unrelated.test.command" }
+
+// testSaga is started by startCommand, completed by finishCommand and dispatches finishCommand on timeout.
+type testSaga struct{}
+
+func (s testSaga) Type() Type {
+	return testSagaType
+}
+
+func (s testSaga) Starts(command bus.Command) bool {
+	_, ok := command.(startCommand)
+
+	return ok
+}
+
+func (s testSaga) Handle(state *State, command bus.Command, err error) []bus.Command {
+	switch command.(type) {
+	case startCommand:
+		state.Transition("waiting")
+		state.ScheduleTimeout(time.Now().Add(time.Minute))
+	case finishCommand:
+		if err != nil {
+			state.Fail(err)
+
+			return nil
+		}
+
+		state.Complete()
+	}
+
+	return nil
+}
+
+func (s testSaga) Timeout(state *State) []bus.Command {
+	state.Transition("finishing")
+
+	return []bus.Command{finishCommand{id: state.AggregateID}}
+}
+
+type inMemoryRepository struct {
+	states map[string]State
+}
+
+func newInMemoryRepository() *inMemoryRepository {
+	return &inMemoryRepository{states: make(map[string]State)}
+}
+
+func (r *inMemoryRepository) Get(_ context.Context, sagaType Type, aggregateID string) (*State, error) {
+	state, ok := r.states[string(sagaType)+aggregateID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	return &state, nil
+}
+
+func (r *inMemoryRepository) Save(_ context.Context, state *State) error {
+	key := string(state.Type) + state.AggregateID
+
+	if r.states[key].Version != state.Version {
+		return ErrConcurrentUpdate
+	}
+
+	state.Version++
+	r.states[key] = *state
+
+	return nil
+}
+
+func (r *inMemoryRepository) ListDue(_ context.Context, now time.Time, _ uint) ([]*State, error) {
+	var states []*State
+
+	for _, state := range r.states {
+		state := state
+		if state.IsRunning() && state.TimeoutAt != nil && !state.TimeoutAt.After(now) {
+			states = append(states, &state)
+		}
+	}
+
+	return states, nil
+}
+
+func (r *inMemoryRepository) List(context.Context, Filter) ([]*State, error) {
+	return nil, nil
+}
+
+// Do implements transaction.UnitOfWork interface. States saved by failed fn are rolled back.
+func (r *inMemoryRepository) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	saved := make(map[string]State, len(r.states))
+
+	for key, state := range r.states {
+		saved[key] = state
+	}
+
+	if err := fn(ctx); err != nil {
+		r.states = saved
+
+		return err
+	}
+
+	return nil
+}
+
+func TestManagerStartsSaga(t *testing.T) {
+	// assign
+	repository := newInMemoryRepository()
+	manager := NewManager(repository, repository, testSaga{})
+
+	// act
+	err := manager.Handle(context.Background(), mocks.NewCommandBus(t), startCommand{id: "1"}, nil)
+
+	// assert
+	require.NoError(t, err)
+
+	state, err := repository.Get(context.Background(), testSagaType, "1")
+	require.NoError(t, err)
+	assert.Equal(t, Running, state.Status)
+	assert.Equal(t, "waiting", state.Step)
+	assert.NotNil(t, state.TimeoutAt)
+	assert.Equal(t, 1, state.Version)
+}
+
+func TestManagerIgnoresFailedAndUnrelatedCommands(t *testing.T) {
+	// assign
+	repository := newInMemoryRepository()
+	manager := NewManager(repository, repository, testSaga{})
+
+	// act
+	failedErr := manager.Handle(context.Background(), mocks.NewCommandBus(t), startCommand{id: "1"}, errTestCommandFailed)
+	finishErr := manager.Handle(context.Background(), mocks.NewCommandBus(t), finishCommand{id: "1"}, nil)
+	unrelatedErr := manager.Handle(context.Background(), mocks.NewCommandBus(t), unrelatedCommand{}, nil)
+
+	// assert
+	require.NoError(t, failedErr)
+	require.NoError(t, finishErr)
+	require.NoError(t, unrelatedErr)
+	assert.Empty(t, repository.states)
+}
+
+func TestManagerCompletesSaga(t *testing.T) {
+	// assign
+	repository := newInMemoryRepository()
+	manager := NewManager(repository, repository, testSaga{})
+	require.NoError(t, manager.Handle(context.Background(), mocks.NewCommandBus(t), startCommand{id: "1"}, nil))
+
+	// act
+	err := manager.Handle(context.Background(), mocks.NewCommandBus(t), finishCommand{id: "1"}, nil)
+
+	// assert
+	require.NoError(t, err)
+
+	state, _ := repository.Get(context.Background(), testSagaType, "1")
+	assert.Equal(t, Completed, state.Status)
+	assert.Nil(t, state.TimeoutAt)
+}
+
+func TestManagerHandleTimeoutsDispatchesFollowUpCommands(t *testing.T) {
+	// assign
+	repository := newInMemoryRepository()
+	commandBus := mocks.NewCommandBus(t)
+	manager := NewManager(repository, repository, testSaga{})
+	require.NoError(t, manager.Handle(context.Background(), commandBus, startCommand{id: "1"}, nil))
+	commandBus.On("Dispatch", mock.Anything, finishCommand{id: "1"}).Return(nil).Once()
+
+	// act
+	err := manager.HandleTimeouts(context.Background(), commandBus, time.Now().Add(time.Hour))
+
+	// assert
+	require.NoError(t, err)
+
+	state, _ := repository.Get(context.Background(), testSagaType, "1")
+	assert.Equal(t, "finishing", state.Step)
+	assert.Nil(t, state.TimeoutAt)
+}
+
+func TestManagerHandleTimeoutsSkipsNotDueSagas(t *testing.T) {
+	// assign
+	repository := newInMemoryRepository()
+	commandBus := mocks.NewCommandBus(t)
+	manager := NewManager(repository, repository, testSaga{})
+	require.NoError(t, manager.Handle(context.Background(), commandBus, startCommand{id: "1"}, nil))
+
+	// act
+	err := manager.HandleTimeouts(context.Background(), commandBus, time.Now())
+
+	// assert
+	require.NoError(t, err)
+	commandBus.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func TestManagerFollowUpCommandError(t *testing.T) {
+	// assign
+	repository := newInMemoryRepository()
+	commandBus := mocks.NewCommandBus(t)
+	manager := NewManager(repository, repository, testSaga{})
+	require.NoError(t, manager.Handle(context.Background(), commandBus, startCommand{id: "1"}, nil))
+	commandBus.On("Dispatch", mock.Anything, finishCommand{id: "1"}).Return(errTestCommandFailed).Once()
+
+	// act
+	err := manager.HandleTimeouts(context.Background(), commandBus, time.Now().Add(time.Hour))
+
+	// assert
+	assert.ErrorIs(t, err, errTestCommandFailed)
+
+	state, _ := repository.Get(context.Background(), testSagaType, "1")
+	assert.Equal(t, "waiting", state.Step)
+	assert.NotNil(t, state.TimeoutAt)
+	assert.True(t, state.IsStuck(time.Now().Add(time.Hour), time.Minute))
+}
+
+func TestStateIsStuck(t *testing.T) {
+	// assign
+	state := NewState(testSagaType, "1")
+	state.ScheduleTimeout(time.Now().Add(-time.Hour))
+
+	// act
+	stuck := state.IsStuck(time.Now(), time.Minute)
+	notYetStuck := state.IsStuck(time.Now(), 2*time.Hour)
+
+	// assert
+	assert.True(t, stuck)
+	assert.False(t, notYetStuck)
+}
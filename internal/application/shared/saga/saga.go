@@ -0,0 +1,133 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+var (
+	ErrStateNotFound     = errors.New("saga state not found")
+	ErrConcurrentUpdate  = errors.New("saga state is concurrently updated")
+	ErrInvalidSagaStatus = errors.New("invalid saga status")
+)
+
+// Type represents the saga type, one state of every saga type is kept per aggregate.
+type Type string
+
+// Status represents the saga instance status.
+type Status string
+
+const (
+	Running   Status = "running"
+	Completed Status = "completed"
+	Failed    Status = "failed"
+)
+
+// NewStatus validates saga status.
+func NewStatus(value string) (Status, error) {
+	switch status := Status(value); status {
+	case Running, Completed, Failed:
+		return status, nil
+	default:
+		return "", ErrInvalidSagaStatus
+	}
+}
+
+// State represents persisted state of a single saga instance keyed by saga type and aggregate id.
+type State struct {
+	Type        Type
+	AggregateID string
+	Step        string
+	Status      Status
+	Data        map[string]string
+	Error       string
+	TimeoutAt   *time.Time
+	Version     int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewState creates a new running saga State without step.
+func NewState(sagaType Type, aggregateID string) *State {
+	now := time.Now()
+
+	return &State{
+		Type:        sagaType,
+		AggregateID: aggregateID,
+		Status:      Running,
+		Data:        make(map[string]string),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Transition moves saga to the next step and clears its timeout.
+func (s *State) Transition(step string) {
+	s.Step = step
+	s.TimeoutAt = nil
+	s.UpdatedAt = time.Now()
+}
+
+// ScheduleTimeout sets the moment when saga reacts to timeout unless it moves further before.
+func (s *State) ScheduleTimeout(at time.Time) {
+	s.TimeoutAt = &at
+	s.UpdatedAt = time.Now()
+}
+
+// Complete marks saga as successfully finished.
+func (s *State) Complete() {
+	s.Status = Completed
+	s.TimeoutAt = nil
+	s.UpdatedAt = time.Now()
+}
+
+// Fail marks saga as failed with specific error.
+func (s *State) Fail(err error) {
+	s.Status = Failed
+	s.Error = err.Error()
+	s.TimeoutAt = nil
+	s.UpdatedAt = time.Now()
+}
+
+// IsRunning reports whether saga still reacts to commands and timeouts.
+func (s *State) IsRunning() bool {
+	return s.Status == Running
+}
+
+// IsStuck reports whether running saga timeout expired more than stuckAfter ago and is still not handled.
+func (s *State) IsStuck(now time.Time, stuckAfter time.Duration) bool {
+	return s.IsRunning() && s.TimeoutAt != nil && now.Sub(*s.TimeoutAt) > stuckAfter
+}
+
+// Saga defines process manager reacting to command outcomes and timeouts of a single aggregate.
+// Saga changes given State and returns follow-up commands, it must not have side effects of its own.
+type Saga interface {
+	Type() Type
+	// Starts reports whether successfully handled command starts a new saga instance.
+	Starts(command bus.Command) bool
+	// Handle reacts to the outcome of command related to the saga aggregate.
+	Handle(state *State, command bus.Command, err error) []bus.Command
+	// Timeout reacts to expired State timeout.
+	Timeout(state *State) []bus.Command
+}
+
+// Filter represents saga states listing criteria. Zero values are ignored.
+type Filter struct {
+	Status        Status
+	TimeoutBefore *time.Time
+	Limit         uint
+}
+
+// Repository defines saga State persistence.
+// Save must fail with ErrConcurrentUpdate if state Version is changed since it was loaded and increment it otherwise.
+type Repository interface {
+	Get(ctx context.Context, sagaType Type, aggregateID string) (*State, error)
+	Save(ctx context.Context, state *State) error
+	ListDue(ctx context.Context, now time.Time, limit uint) ([]*State, error)
+	List(ctx context.Context, filter Filter) ([]*State, error)
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=Repository --structname=SagaRepository --filename=saga_repository.go
@@ -0,0 +1,179 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit bounds the search of the next run, so impossible schedules like "0 0 30 2 *" terminate.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+var ErrInvalidCronExpression = errors.New("invalid cron expression")
+
+// cronField describes the allowed range of a single cron expression field.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// CronSchedule represents parsed standard 5-field cron expression: minute, hour, day of month, month, day of week.
+// Every field supports "*", single values, ranges "a-b", steps "*/n" or "a-b/n" and comma separated lists.
+// Day of week 7 is the same as 0 (Sunday). If both day fields are restricted, matching either of them is enough.
+type CronSchedule struct {
+	expression                         string
+	minutes, hours, days, months, dows uint64
+	daysRestricted, dowsRestricted     bool
+}
+
+// ParseCron parses cron expression.
+func ParseCron(expression string) (CronSchedule, error) {
+	parts := strings.Fields(expression)
+	if len(parts) != len(cronFields) {
+		return CronSchedule{}, fmt.Errorf("%w %q: expected %d fields", ErrInvalidCronExpression, expression, len(cronFields))
+	}
+
+	var bits [5]uint64
+
+	for i, part := range parts {
+		fieldBits, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return CronSchedule{}, fmt.Errorf("%w %q: %s", ErrInvalidCronExpression, expression, err)
+		}
+
+		bits[i] = fieldBits
+	}
+
+	// Sunday can be written both as 0 and 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return CronSchedule{
+		expression:     expression,
+		minutes:        bits[0],
+		hours:          bits[1],
+		days:           bits[2],
+		months:         bits[3],
+		dows:           bits[4],
+		daysRestricted: parts[2] != "*",
+		dowsRestricted: parts[4] != "*",
+	}, nil
+}
+
+// String returns the original cron expression.
+func (s CronSchedule) String() string {
+	return s.expression
+}
+
+// Next returns the first moment matching schedule strictly after t, with minute precision.
+// Zero time is returned if nothing matches in the next five years.
+func (s CronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		switch {
+		case s.months&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hours&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minutes&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// matchesDay checks both day of month and day of week fields.
+func (s CronSchedule) matchesDay(t time.Time) bool {
+	day := s.days&(1<<uint(t.Day())) != 0
+	dow := s.dows&(1<<uint(t.Weekday())) != 0
+
+	if s.daysRestricted && s.dowsRestricted {
+		return day || dow
+	}
+
+	return day && dow
+}
+
+// parseCronField converts a single cron field to bit set of allowed values.
+func parseCronField(value string, field cronField) (uint64, error) {
+	var bits uint64
+
+	for _, item := range strings.Split(value, ",") {
+		rangePart, step := item, 1
+
+		if i := strings.Index(item, "/"); i >= 0 {
+			parsedStep, err := strconv.Atoi(item[i+1:])
+			if err != nil || parsedStep <= 0 {
+				return 0, fmt.Errorf("invalid %s step %q", field.name, item)
+			}
+
+			rangePart, step = item[:i], parsedStep
+		}
+
+		from, to := field.min, field.max
+
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+
+			var err error
+			if from, err = parseCronValue(bounds[0], field); err != nil {
+				return 0, err
+			}
+
+			if to, err = parseCronValue(bounds[1], field); err != nil {
+				return 0, err
+			}
+
+			if from > to {
+				return 0, fmt.Errorf("invalid %s range %q", field.name, rangePart)
+			}
+		default:
+			single, err := parseCronValue(rangePart, field)
+			if err != nil {
+				return 0, err
+			}
+
+			from = single
+
+			if step == 1 {
+				to = single
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseCronValue parses a single numeric cron value and checks its range.
+func parseCronValue(value string, field cronField) (int, error) {
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < field.min || parsed > field.max {
+		return 0, fmt.Errorf("invalid %s value %q", field.name, value)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	// Wednesday
+	from := time.Date(2022, time.November, 16, 10, 30, 45, 0, time.UTC)
+
+	testCases := map[string]struct {
+		expression string
+		expected   time.Time
+	}{
+		"every minute":              {"* * * * *", time.Date(2022, time.November, 16, 10, 31, 0, 0, time.UTC)},
+		"every 15 minutes":          {"*/15 * * * *", time.Date(2022, time.November, 16, 10, 45, 0, 0, time.UTC)},
+		"daily at midnight":         {"0 0 * * *", time.Date(2022, time.November, 17, 0, 0, 0, 0, time.UTC)},
+		"working hours list":        {"0 9,13,17 * * *", time.Date(2022, time.November, 16, 13, 0, 0, 0, time.UTC)},
+		"hour range with step":      {"0 8-20/4 * * *", time.Date(2022, time.November, 16, 12, 0, 0, 0, time.UTC)},
+		"sunday as seven":           {"0 12 * * 7", time.Date(2022, time.November, 20, 12, 0, 0, 0, time.UTC)},
+		"first day of month":        {"0 0 1 * *", time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC)},
+		"next year":                 {"0 0 1 1 *", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		"day of month or weekday":   {"0 0 20 * 5", time.Date(2022, time.November, 18, 0, 0, 0, 0, time.UTC)},
+		"leap day":                  {"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		"impossible date":           {"0 0 30 2 *", time.Time{}},
+		"single value with step":    {"5/20 * * * *", time.Date(2022, time.November, 16, 10, 45, 0, 0, time.UTC)},
+		"minute of the current one": {"30 10 * * *", time.Date(2022, time.November, 17, 10, 30, 0, 0, time.UTC)},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			// assign
+			schedule, err := ParseCron(testCase.expression)
+			require.NoError(t, err)
+
+			// act
+			next := schedule.Next(from)
+
+			// assert
+			assert.Equal(t, testCase.expected, next)
+		})
+	}
+}
+
+func TestParseCronError(t *testing.T) {
+	testCases := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+	}
+
+	for _, expression := range testCases {
+		expression := expression
+
+		t.Run(expression, func(t *testing.T) {
+			// act
+			_, err := ParseCron(expression)
+
+			// assert
+			assert.ErrorIs(t, err, ErrInvalidCronExpression)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+var (
+	ErrEntryNotFound     = errors.New("scheduled command not found")
+	ErrEntryNotScheduled = errors.New("scheduled command is not waiting for run")
+	ErrInvalidStatus     = errors.New("invalid scheduled command status")
+)
+
+// Status represents the scheduled command status.
+type Status string
+
+const (
+	Scheduled Status = "scheduled"
+	Completed Status = "completed"
+	Failed    Status = "failed"
+	Cancelled Status = "cancelled"
+)
+
+// NewStatus validates scheduled command status.
+func NewStatus(value string) (Status, error) {
+	switch status := Status(value); status {
+	case Scheduled, Completed, Failed, Cancelled:
+		return status, nil
+	default:
+		return "", ErrInvalidStatus
+	}
+}
+
+// Entry represents command persisted to be dispatched at RunAt. Recurring entry has Cron expression
+// and is rescheduled after every run, so it stays scheduled until cancelled.
+type Entry struct {
+	ID          string
+	CommandType bus.CommandType
+	Payload     []byte
+	Cron        string
+	Status      Status
+	RunAt       time.Time
+	Runs        int
+	LastRunAt   *time.Time
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewEntry creates a new scheduled Entry.
+func NewEntry(id string, commandType bus.CommandType, payload []byte, runAt time.Time, cron string) *Entry {
+	now := time.Now()
+
+	return &Entry{
+		ID:          id,
+		CommandType: commandType,
+		Payload:     payload,
+		Cron:        cron,
+		Status:      Scheduled,
+		RunAt:       runAt,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsRecurring reports whether entry is rescheduled after run.
+func (e *Entry) IsRecurring() bool {
+	return e.Cron != ""
+}
+
+// Finish records run result. Entry is rescheduled to next if it is not zero, completed or failed otherwise.
+func (e *Entry) Finish(now time.Time, err error, next time.Time) {
+	e.Runs++
+	e.LastRunAt = &now
+	e.LastError = ""
+	e.UpdatedAt = now
+
+	if err != nil {
+		e.LastError = err.Error()
+	}
+
+	switch {
+	case !next.IsZero():
+		e.RunAt = next
+	case err != nil:
+		e.Status = Failed
+	default:
+		e.Status = Completed
+	}
+}
+
+// Filter represents scheduled commands listing criteria. Zero values are ignored.
+type Filter struct {
+	Status Status
+	Limit  uint
+}
+
+// Repository defines scheduled commands persistence.
+type Repository interface {
+	Add(ctx context.Context, entry *Entry) error
+	List(ctx context.Context, filter Filter) ([]*Entry, error)
+	// Cancel cancels scheduled entry. It fails with ErrEntryNotFound or ErrEntryNotScheduled if entry is not waiting for run.
+	Cancel(ctx context.Context, id string) error
+	// Trigger moves run time of scheduled entry to now. It fails like Cancel if entry is not waiting for run.
+	Trigger(ctx context.Context, id string, now time.Time) error
+	// RunNext locks the earliest entry due at now, so other instances skip it, passes it to run and saves entry changes.
+	// Database changes made by run are rolled back if it returns an error. It reports false if no entry is due.
+	RunNext(ctx context.Context, now time.Time, run func(ctx context.Context, entry *Entry) error) (bool, error)
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=Repository --structname=ScheduledCommandRepository --filename=scheduled_command_repository.go
@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+// Scheduler persists commands to be dispatched later and dispatches the due ones through bus.CommandBus.
+type Scheduler struct {
+	repository Repository
+	codec      *bus.CommandCodec
+	commandBus bus.CommandBus
+}
+
+// NewScheduler creates a new Scheduler.
+func NewScheduler(repository Repository, codec *bus.CommandCodec, commandBus bus.CommandBus) *Scheduler {
+	return &Scheduler{
+		repository: repository,
+		codec:      codec,
+		commandBus: commandBus,
+	}
+}
+
+// Schedule persists command to be dispatched once at runAt. Command is validated upfront.
+func (s *Scheduler) Schedule(ctx context.Context, id string, command bus.Command, runAt time.Time) error {
+	return s.add(ctx, id, command, runAt, "")
+}
+
+// ScheduleRecurring persists command to be dispatched at every moment matching cron expression.
+func (s *Scheduler) ScheduleRecurring(ctx context.Context, id string, command bus.Command, cron string) error {
+	schedule, err := ParseCron(cron)
+	if err != nil {
+		return err
+	}
+
+	runAt, err := nextRun(schedule, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return s.add(ctx, id, command, runAt, schedule.String())
+}
+
+// RunDue dispatches at most limit commands due at now and returns the number of dispatched ones.
+// Command failures are recorded in entries, only storage errors are returned.
+func (s *Scheduler) RunDue(ctx context.Context, now time.Time, limit uint) (uint, error) {
+	var count uint
+
+	for count < limit {
+		ran, err := s.repository.RunNext(ctx, now, func(ctx context.Context, entry *Entry) error {
+			return s.run(ctx, entry, now)
+		})
+		if err != nil || !ran {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+// run decodes and dispatches entry command and records the result.
+// Recurring entry whose schedule is broken or never matches again fails without dispatching.
+func (s *Scheduler) run(ctx context.Context, entry *Entry, now time.Time) error {
+	var next time.Time
+
+	if entry.IsRecurring() {
+		schedule, err := ParseCron(entry.Cron)
+		if err == nil {
+			next, err = nextRun(schedule, now)
+		}
+
+		if err != nil {
+			entry.Finish(now, err, time.Time{})
+
+			return err
+		}
+	}
+
+	command, err := s.codec.Decode(entry.CommandType, entry.Payload)
+	if err == nil {
+		err = s.commandBus.Dispatch(ctx, command)
+	}
+
+	entry.Finish(now, err, next)
+
+	return err
+}
+
+// nextRun returns the first moment matching schedule after t.
+// Schedule which never matches, e.g. "0 0 30 2 *", is invalid, because zero time would make entry run at once.
+func nextRun(schedule CronSchedule, t time.Time) (time.Time, error) {
+	next := schedule.Next(t)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("%w %q: never matches", ErrInvalidCronExpression, schedule)
+	}
+
+	return next, nil
+}
+
+// add validates, encodes and persists command.
+func (s *Scheduler) add(ctx context.Context, id string, command bus.Command, runAt time.Time, cron string) error {
+	if err := bus.Validate(command); err != nil {
+		return err
+	}
+
+	payload, err := s.codec.Encode(command)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.Add(ctx, NewEntry(id, command.Type(), payload, runAt, cron))
+}
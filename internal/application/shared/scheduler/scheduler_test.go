@@ -0,0 +1,203 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks"
+)
+
+const testCommandType bus.CommandType = "test.command"
+
+var errTestCommandFailed = errors.New("test command failed")
+
+type testCommand struct {
+	Value string `json:"value"`
+}
+
+func (c testCommand) Type() bus.CommandType {
+	return testCommandType
+}
+
+func (c testCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if c.Value == "" {
+		validationError.Add("value", bus.ErrFieldRequired)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+type inMemoryRepository struct {
+	entries map[string]*Entry
+}
+
+func (r *inMemoryRepository) Add(_ context.Context, entry *Entry) error {
+	r.entries[entry.ID] = entry
+
+	return nil
+}
+
+func (r *inMemoryRepository) List(context.Context, Filter) ([]*Entry, error) {
+	return nil, nil
+}
+
+func (r *inMemoryRepository) Cancel(context.Context, string) error {
+	return nil
+}
+
+func (r *inMemoryRepository) Trigger(context.Context, string, time.Time) error {
+	return nil
+}
+
+func (r *inMemoryRepository) RunNext(
+	ctx context.Context,
+	now time.Time,
+	run func(ctx context.Context, entry *Entry) error,
+) (bool, error) {
+	var due []*Entry
+
+	for _, entry := range r.entries {
+		if entry.Status == Scheduled && !entry.RunAt.After(now) {
+			due = append(due, entry)
+		}
+	}
+
+	if len(due) == 0 {
+		return false, nil
+	}
+
+	sort.Slice(due, func(i, j int) bool { return due[i].RunAt.Before(due[j].RunAt) })
+	_ = run(ctx, due[0])
+
+	return true, nil
+}
+
+func newTestScheduler(commandBus bus.CommandBus) (*Scheduler, *inMemoryRepository) {
+	codec := bus.NewCommandCodec()
+	bus.RegisterCommandCodec[testCommand](codec)
+
+	repository := &inMemoryRepository{entries: make(map[string]*Entry)}
+
+	return NewScheduler(repository, codec, commandBus), repository
+}
+
+func TestSchedulerRunsDueCommand(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	commandBus.On("Dispatch", mock.Anything, testCommand{Value: "due"}).Return(nil).Once()
+	scheduler, repository := newTestScheduler(commandBus)
+	now := time.Now()
+
+	require.NoError(t, scheduler.Schedule(context.Background(), "due", testCommand{Value: "due"}, now.Add(-time.Second)))
+	require.NoError(t, scheduler.Schedule(context.Background(), "later", testCommand{Value: "later"}, now.Add(time.Hour)))
+
+	// act
+	count, err := scheduler.RunDue(context.Background(), now, 10)
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), count)
+	assert.Equal(t, Completed, repository.entries["due"].Status)
+	assert.Equal(t, 1, repository.entries["due"].Runs)
+	assert.Equal(t, Scheduled, repository.entries["later"].Status)
+}
+
+func TestSchedulerRecordsCommandFailure(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	commandBus.On("Dispatch", mock.Anything, mock.Anything).Return(errTestCommandFailed)
+	scheduler, repository := newTestScheduler(commandBus)
+	now := time.Now()
+
+	require.NoError(t, scheduler.Schedule(context.Background(), "due", testCommand{Value: "due"}, now))
+
+	// act
+	_, err := scheduler.RunDue(context.Background(), now, 10)
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, Failed, repository.entries["due"].Status)
+	assert.Equal(t, errTestCommandFailed.Error(), repository.entries["due"].LastError)
+}
+
+func TestSchedulerReschedulesRecurringCommand(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	commandBus.On("Dispatch", mock.Anything, mock.Anything).Return(errTestCommandFailed)
+	scheduler, repository := newTestScheduler(commandBus)
+
+	require.NoError(t, scheduler.ScheduleRecurring(context.Background(), "recurring", testCommand{Value: "v"}, "0 * * * *"))
+	runAt := repository.entries["recurring"].RunAt
+
+	// act
+	_, err := scheduler.RunDue(context.Background(), runAt, 10)
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, Scheduled, repository.entries["recurring"].Status)
+	assert.Equal(t, runAt.Add(time.Hour), repository.entries["recurring"].RunAt)
+	assert.Equal(t, errTestCommandFailed.Error(), repository.entries["recurring"].LastError)
+}
+
+func TestSchedulerRespectsLimit(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	commandBus.On("Dispatch", mock.Anything, testCommand{Value: "first"}).Return(nil).Once()
+	scheduler, _ := newTestScheduler(commandBus)
+	now := time.Now()
+
+	require.NoError(t, scheduler.Schedule(context.Background(), "first", testCommand{Value: "first"}, now.Add(-time.Minute)))
+	require.NoError(t, scheduler.Schedule(context.Background(), "second", testCommand{Value: "second"}, now))
+
+	// act
+	count, err := scheduler.RunDue(context.Background(), now, 1)
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), count)
+}
+
+func TestSchedulerScheduleErrors(t *testing.T) {
+	// assign
+	scheduler, repository := newTestScheduler(mocks.NewCommandBus(t))
+
+	// act
+	invalidErr := scheduler.Schedule(context.Background(), "invalid", testCommand{}, time.Now())
+	cronErr := scheduler.ScheduleRecurring(context.Background(), "cron", testCommand{Value: "v"}, "not cron")
+	neverErr := scheduler.ScheduleRecurring(context.Background(), "never", testCommand{Value: "v"}, "0 0 30 2 *")
+
+	// assert
+	assert.ErrorIs(t, invalidErr, bus.ErrValidationFailed)
+	assert.ErrorIs(t, cronErr, ErrInvalidCronExpression)
+	assert.ErrorIs(t, neverErr, ErrInvalidCronExpression)
+	assert.Empty(t, repository.entries)
+}
+
+func TestSchedulerFailsRecurringCommandNeverMatchingAgain(t *testing.T) {
+	// assign
+	commandBus := mocks.NewCommandBus(t)
+	scheduler, repository := newTestScheduler(commandBus)
+	now := time.Now()
+
+	payload, err := scheduler.codec.Encode(testCommand{Value: "v"})
+	require.NoError(t, err)
+	require.NoError(t, repository.Add(context.Background(), NewEntry("never", testCommandType, payload, now, "0 0 30 2 *")))
+
+	// act
+	_, err = scheduler.RunDue(context.Background(), now, 10)
+
+	// assert
+	require.NoError(t, err)
+	commandBus.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	assert.Equal(t, Failed, repository.entries["never"].Status)
+	assert.Contains(t, repository.entries["never"].LastError, ErrInvalidCronExpression.Error())
+}
@@ -0,0 +1,23 @@
+package transaction
+
+import "context"
+
+// UnitOfWork defines interface for running several repository calls atomically e.g. inside database transaction.
+// Implementations must pass transaction to repositories via context.Context given to the function
+// and must join already started transaction in case of nested calls.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Detector reports whether context carries transaction started by UnitOfWork.
+// Code must not retry failed statements inside such transaction, because only the outermost call can roll it back.
+type Detector interface {
+	InTransaction(ctx context.Context) bool
+}
+
+// Notifier defers functions until transaction carried by context is committed, e.g. to invalidate caches
+// only once changes are visible to other readers. Function runs at once if context carries no transaction
+// and never runs if transaction is rolled back.
+type Notifier interface {
+	AfterCommit(ctx context.Context, fn func())
+}
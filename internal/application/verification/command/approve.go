@@ -2,9 +2,10 @@ package command
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
 
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/service"
 )
 
@@ -12,7 +13,8 @@ const ApproveVerificationCommandType bus.CommandType = "approve.verification.com
 
 // ApproveVerificationCommand is the command dispatched to approve verification.
 type ApproveVerificationCommand struct {
-	uuid string
+	uuid  string
+	actor string
 }
 
 // NewApproveVerificationCommand creates a new ApproveVerificationCommand.
@@ -22,11 +24,63 @@ func NewApproveVerificationCommand(UUID string) ApproveVerificationCommand {
 	}
 }
 
+// WithActor returns copy of ApproveVerificationCommand initiated by actor, e.g. support agent or "system:expiry".
+func (c ApproveVerificationCommand) WithActor(actor string) ApproveVerificationCommand {
+	c.actor = actor
+
+	return c
+}
+
+// Actor implements bus.ActorAware interface.
+func (c ApproveVerificationCommand) Actor() string {
+	return c.actor
+}
+
 // Type implements bus.Command interface.
 func (c ApproveVerificationCommand) Type() bus.CommandType {
 	return ApproveVerificationCommandType
 }
 
+// AggregateID implements bus.AggregateAware interface.
+func (c ApproveVerificationCommand) AggregateID() string {
+	return c.uuid
+}
+
+// approveVerificationPayload represents ApproveVerificationCommand JSON structure.
+type approveVerificationPayload struct {
+	UUID  string `json:"uuid"`
+	Actor string `json:"actor,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (c ApproveVerificationCommand) MarshalJSON() ([]byte, error) {
+	return json.Marshal(approveVerificationPayload{UUID: c.uuid, Actor: c.actor})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (c *ApproveVerificationCommand) UnmarshalJSON(data []byte) error {
+	var payload approveVerificationPayload
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*c = NewApproveVerificationCommand(payload.UUID).WithActor(payload.Actor)
+
+	return nil
+}
+
+// Validate implements bus.Validatable interface.
+func (c ApproveVerificationCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := aggregate.NewVerificationUUID(c.uuid); err != nil {
+		validationError.Add("uuid", err)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
 // ApproveVerificationCommandHandler is the ApproveVerificationCommand handler.
 type ApproveVerificationCommandHandler struct {
 	approveVerificationService service.ApproveVerificationService
@@ -39,12 +93,7 @@ func NewApproveVerificationCommandHandler(approveVerificationService service.App
 	}
 }
 
-// Handle implements the bus.CommandHandler interface.
-func (h ApproveVerificationCommandHandler) Handle(ctx context.Context, cmd bus.Command) error {
-	approveVerificationCommand, ok := cmd.(ApproveVerificationCommand)
-	if !ok {
-		return fmt.Errorf("command type %s: %w", cmd.Type(), bus.ErrUnexpectedCommand)
-	}
-
-	return h.approveVerificationService.Approve(ctx, approveVerificationCommand.uuid)
+// Handle implements the bus.TypedCommandHandler interface.
+func (h ApproveVerificationCommandHandler) Handle(ctx context.Context, approveVerificationCommand ApproveVerificationCommand) error {
+	return h.approveVerificationService.Approve(ctx, approveVerificationCommand.uuid, approveVerificationCommand.actor)
 }
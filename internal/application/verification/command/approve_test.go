@@ -21,14 +21,16 @@ func TestHandleUnsupportedCommandrror(t *testing.T) {
 	unsupportedCommand := new(mocks.Command)
 	unsupportedCommand.On("Type").Return(unsupportedCommandType)
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 
 	// act
-	approveVerificationService := service.NewApproveVerificationService(verificationRepositoryMock)
-	approveVerificationCommandHandler := NewApproveVerificationCommandHandler(approveVerificationService)
+	approveVerificationService := service.NewApproveVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	approveVerificationCommandHandler := bus.NewCommandHandler[ApproveVerificationCommand](NewApproveVerificationCommandHandler(approveVerificationService))
 	err := approveVerificationCommandHandler.Handle(context.Background(), unsupportedCommand)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, bus.ErrUnexpectedCommand)
 }
 
@@ -43,17 +45,31 @@ func TestHandleApproveVerificationCommandSuccess(t *testing.T) {
 	approveVerificationCommand := NewApproveVerificationCommand(verification.UUID().Value())
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(verification, nil)
 	verificationRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	historyRepositoryMock.On("Add", mock.Anything, mock.Anything).Return(nil)
 
 	// act
-	approveVerificationService := service.NewApproveVerificationService(verificationRepositoryMock)
+	approveVerificationService := service.NewApproveVerificationService(verificationRepositoryMock, historyRepositoryMock)
 
 	approveVerificationCommandHandler := NewApproveVerificationCommandHandler(approveVerificationService)
 	err := approveVerificationCommandHandler.Handle(context.Background(), approveVerificationCommand)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.NoError(t, err)
 	assert.Equal(t, aggregate.Approved, verification.Status().Value())
 }
+
+func TestApproveVerificationCommandValidationError(t *testing.T) {
+	// assign
+	approveVerificationCommand := NewApproveVerificationCommand("invalidUUID")
+
+	// act
+	err := approveVerificationCommand.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+}
@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/service"
+)
+
+const CancelVerificationCommandType bus.CommandType = "cancel.verification.command"
+
+// CancelVerificationCommand is the command dispatched to cancel verification.
+type CancelVerificationCommand struct {
+	uuid  string
+	actor string
+}
+
+// NewCancelVerificationCommand creates a new CancelVerificationCommand.
+func NewCancelVerificationCommand(UUID string) CancelVerificationCommand {
+	return CancelVerificationCommand{
+		uuid: UUID,
+	}
+}
+
+// WithActor returns copy of CancelVerificationCommand initiated by actor, e.g. support agent or "system:expiry".
+func (c CancelVerificationCommand) WithActor(actor string) CancelVerificationCommand {
+	c.actor = actor
+
+	return c
+}
+
+// Actor implements bus.ActorAware interface.
+func (c CancelVerificationCommand) Actor() string {
+	return c.actor
+}
+
+// Type implements bus.Command interface.
+func (c CancelVerificationCommand) Type() bus.CommandType {
+	return CancelVerificationCommandType
+}
+
+// AggregateID implements bus.AggregateAware interface.
+func (c CancelVerificationCommand) AggregateID() string {
+	return c.uuid
+}
+
+// cancelVerificationPayload represents CancelVerificationCommand JSON structure.
+type cancelVerificationPayload struct {
+	UUID  string `json:"uuid"`
+	Actor string `json:"actor,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (c CancelVerificationCommand) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cancelVerificationPayload{UUID: c.uuid, Actor: c.actor})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (c *CancelVerificationCommand) UnmarshalJSON(data []byte) error {
+	var payload cancelVerificationPayload
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*c = NewCancelVerificationCommand(payload.UUID).WithActor(payload.Actor)
+
+	return nil
+}
+
+// Validate implements bus.Validatable interface.
+func (c CancelVerificationCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := aggregate.NewVerificationUUID(c.uuid); err != nil {
+		validationError.Add("uuid", err)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// CancelVerificationCommandHandler is the CancelVerificationCommand handler.
+type CancelVerificationCommandHandler struct {
+	cancelVerificationService service.CancelVerificationService
+}
+
+// NewCancelVerificationCommandHandler initializes a new CancelVerificationCommandHandler.
+func NewCancelVerificationCommandHandler(cancelVerificationService service.CancelVerificationService) CancelVerificationCommandHandler {
+	return CancelVerificationCommandHandler{
+		cancelVerificationService: cancelVerificationService,
+	}
+}
+
+// Handle implements the bus.TypedCommandHandler interface.
+func (h CancelVerificationCommandHandler) Handle(ctx context.Context, cancelVerificationCommand CancelVerificationCommand) error {
+	return h.cancelVerificationService.Cancel(ctx, cancelVerificationCommand.uuid, cancelVerificationCommand.actor)
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/service"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleUnsupportedCancelCommandError(t *testing.T) {
+	// assign
+	var unsupportedCommandType bus.CommandType = "unsupported_cancel.verification.command"
+
+	unsupportedCommand := new(mocks.Command)
+	unsupportedCommand.On("Type").Return(unsupportedCommandType)
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+
+	// act
+	cancelVerificationService := service.NewCancelVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	cancelVerificationCommandHandler := bus.NewCommandHandler[CancelVerificationCommand](NewCancelVerificationCommandHandler(cancelVerificationService))
+	err := cancelVerificationCommandHandler.Handle(context.Background(), unsupportedCommand)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, bus.ErrUnexpectedCommand)
+}
+
+func TestHandleCancelVerificationCommandSuccess(t *testing.T) {
+	// assign
+	verification, _ := aggregate.NewVerification(
+		uuid.New().String(),
+		aggregate.Identity,
+		"Fancy verification document description",
+	)
+
+	cancelVerificationCommand := NewCancelVerificationCommand(verification.UUID().Value())
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(verification, nil)
+	verificationRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	historyRepositoryMock.On("Add", mock.Anything, mock.Anything).Return(nil)
+
+	// act
+	cancelVerificationService := service.NewCancelVerificationService(verificationRepositoryMock, historyRepositoryMock)
+
+	cancelVerificationCommandHandler := NewCancelVerificationCommandHandler(cancelVerificationService)
+	err := cancelVerificationCommandHandler.Handle(context.Background(), cancelVerificationCommand)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Equal(t, aggregate.Cancelled, verification.Status().Value())
+}
+
+func TestCancelVerificationCommandValidationError(t *testing.T) {
+	// assign
+	cancelVerificationCommand := NewCancelVerificationCommand("invalidUUID")
+
+	// act
+	err := cancelVerificationCommand.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+}
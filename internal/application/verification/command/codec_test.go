@@ -0,0 +1,43 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+func TestVerificationCommandsCodecRoundTrip(t *testing.T) {
+	codec := bus.NewCommandCodec()
+	bus.RegisterCommandCodec[CreateVerificationCommand](codec)
+	bus.RegisterCommandCodec[ApproveVerificationCommand](codec)
+	bus.RegisterCommandCodec[DeclineVerificationCommand](codec)
+	bus.RegisterCommandCodec[CancelVerificationCommand](codec)
+
+	verificationUUID := uuid.New()
+
+	testCases := map[string]bus.Command{
+		"create":  NewCreateVerificationCommand(verificationUUID, "Fancy verification description", aggregate.Identity),
+		"approve": NewApproveVerificationCommand(verificationUUID.String()).WithActor("agent@example.com"),
+		"decline": NewDeclineVerificationCommand(verificationUUID.String(), "Bad photo quality"),
+		"cancel":  NewCancelVerificationCommand(verificationUUID.String()).WithActor("system:expiry"),
+	}
+
+	for name, command := range testCases {
+		command := command
+
+		t.Run(name, func(t *testing.T) {
+			// act
+			payload, encodeErr := codec.Encode(command)
+			decoded, decodeErr := codec.Decode(command.Type(), payload)
+
+			// assert
+			require.NoError(t, encodeErr)
+			require.NoError(t, decodeErr)
+			assert.Equal(t, command, decoded)
+		})
+	}
+}
@@ -2,20 +2,27 @@ package command
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/service"
 )
 
-const CreateVerificationCommandType bus.CommandType = "create.verification.command"
+const (
+	CreateVerificationCommandType bus.CommandType = "create.verification.command"
+	descriptionMinLength                          = 10
+)
 
 // CreateVerificationCommand is the command dispatched to create a new verification
 type CreateVerificationCommand struct {
-	uuid        uuid.UUID
-	description string
-	kind        string
+	uuid              uuid.UUID
+	description       string
+	kind              string
+	externalReference string
+	actor             string
 }
 
 // NewCreateVerificationCommand creates a new CreateVerificationCommand
@@ -27,11 +34,95 @@ func NewCreateVerificationCommand(UUID uuid.UUID, description, kind string) Crea
 	}
 }
 
+// WithExternalReference returns copy of CreateVerificationCommand with reference of the verification in a client system.
+func (c CreateVerificationCommand) WithExternalReference(externalReference string) CreateVerificationCommand {
+	c.externalReference = externalReference
+
+	return c
+}
+
+// WithActor returns copy of CreateVerificationCommand initiated by actor, e.g. support agent or "system:expiry".
+func (c CreateVerificationCommand) WithActor(actor string) CreateVerificationCommand {
+	c.actor = actor
+
+	return c
+}
+
+// Actor implements bus.ActorAware interface.
+func (c CreateVerificationCommand) Actor() string {
+	return c.actor
+}
+
 // Type implements bus.Command interface
 func (c CreateVerificationCommand) Type() bus.CommandType {
 	return CreateVerificationCommandType
 }
 
+// AggregateID implements bus.AggregateAware interface
+func (c CreateVerificationCommand) AggregateID() string {
+	return c.uuid.String()
+}
+
+// createVerificationPayload represents CreateVerificationCommand JSON structure.
+type createVerificationPayload struct {
+	UUID              uuid.UUID `json:"uuid"`
+	Description       string    `json:"description"`
+	Kind              string    `json:"kind"`
+	ExternalReference string    `json:"externalReference,omitempty"`
+	Actor             string    `json:"actor,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (c CreateVerificationCommand) MarshalJSON() ([]byte, error) {
+	return json.Marshal(createVerificationPayload{
+		UUID:              c.uuid,
+		Description:       c.description,
+		Kind:              c.kind,
+		ExternalReference: c.externalReference,
+		Actor:             c.actor,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (c *CreateVerificationCommand) UnmarshalJSON(data []byte) error {
+	var payload createVerificationPayload
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*c = NewCreateVerificationCommand(payload.UUID, payload.Description, payload.Kind).
+		WithExternalReference(payload.ExternalReference).
+		WithActor(payload.Actor)
+
+	return nil
+}
+
+// Validate implements bus.Validatable interface.
+func (c CreateVerificationCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if c.uuid == uuid.Nil {
+		validationError.Add("uuid", aggregate.ErrInvalidVerificationUUID)
+	}
+
+	if _, err := aggregate.NewVerificationDescription(c.description); err != nil {
+		validationError.Add("description", err)
+	} else if utf8.RuneCountInString(c.description) < descriptionMinLength {
+		validationError.Addf("description", bus.ErrFieldTooShort, "must be at least %d characters long", descriptionMinLength)
+	}
+
+	if _, err := aggregate.NewVerificationKind(c.kind); err != nil {
+		validationError.Add("kind", err)
+	}
+
+	if _, err := aggregate.NewVerificationExternalReference(c.externalReference); err != nil {
+		validationError.Addf("externalReference", bus.ErrFieldTooLong, "must be at most %d characters long", aggregate.ExternalReferenceMaxLength)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
 // CreateVerificationCommandHandler is the CreateVerificationCommand handler
 type CreateVerificationCommandHandler struct {
 	createVerificationService service.CreateVerificationService
@@ -44,17 +135,14 @@ func NewCreateVerificationCommandHandler(createVerificationService service.Creat
 	}
 }
 
-// Handle implements the bus.CommandHandler interface.
-func (h CreateVerificationCommandHandler) Handle(ctx context.Context, cmd bus.Command) error {
-	createVerificationCommand, ok := cmd.(CreateVerificationCommand)
-	if !ok {
-		return fmt.Errorf("command type %s: %w", cmd.Type(), bus.ErrUnexpectedCommand)
-	}
-
+// Handle implements the bus.TypedCommandHandler interface.
+func (h CreateVerificationCommandHandler) Handle(ctx context.Context, createVerificationCommand CreateVerificationCommand) error {
 	return h.createVerificationService.Create(
 		ctx,
 		createVerificationCommand.uuid,
 		createVerificationCommand.description,
 		createVerificationCommand.kind,
+		createVerificationCommand.externalReference,
+		createVerificationCommand.actor,
 	)
 }
@@ -2,11 +2,13 @@ package command
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/service"
@@ -22,15 +24,17 @@ func TestHandleUnsupportedCreateVerificationCommandError(t *testing.T) {
 	unsupportedCommand.On("Type").Return(unsupportedCommandType)
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 
 	// act
-	createVerificationService := service.NewCreateVerificationService(verificationRepositoryMock)
+	createVerificationService := service.NewCreateVerificationService(verificationRepositoryMock, historyRepositoryMock)
 
-	createVerificationCommandHandler := NewCreateVerificationCommandHandler(createVerificationService)
+	createVerificationCommandHandler := bus.NewCommandHandler[CreateVerificationCommand](NewCreateVerificationCommandHandler(createVerificationService))
 	err := createVerificationCommandHandler.Handle(context.Background(), unsupportedCommand)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, bus.ErrUnexpectedCommand)
 }
 
@@ -43,15 +47,57 @@ func TestHandleCreateVerificationCommandSuccess(t *testing.T) {
 	createVerificationCommand := NewCreateVerificationCommand(verificationUUID, description, kind)
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("Add", mock.Anything, mock.Anything).Return(nil)
+	historyRepositoryMock.On("Add", mock.Anything, mock.Anything).Return(nil)
 
 	// act
-	createVerificationService := service.NewCreateVerificationService(verificationRepositoryMock)
+	createVerificationService := service.NewCreateVerificationService(verificationRepositoryMock, historyRepositoryMock)
 
 	createVerificationCommandHandler := NewCreateVerificationCommandHandler(createVerificationService)
 	err := createVerificationCommandHandler.Handle(context.Background(), createVerificationCommand)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestCreateVerificationCommandValidationError(t *testing.T) {
+	// assign
+	createVerificationCommand := NewCreateVerificationCommand(uuid.Nil, "Too short", "invalidKind").
+		WithExternalReference(strings.Repeat("a", aggregate.ExternalReferenceMaxLength+1))
+
+	// act
+	err := createVerificationCommand.Validate()
+
+	// assert
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Equal(t, []string{"uuid", "description", "kind", "externalReference"}, fieldNames(validationError))
+}
+
+func TestCreateVerificationCommandValidationSuccess(t *testing.T) {
+	// assign
+	createVerificationCommand := NewCreateVerificationCommand(
+		uuid.New(),
+		"Fancy verification document description",
+		aggregate.Document,
+	).WithExternalReference("applicant-42")
+
+	// act
+	err := createVerificationCommand.Validate()
+
+	// assert
 	assert.NoError(t, err)
 }
+
+func fieldNames(validationError *bus.ValidationError) []string {
+	var fields []string
+
+	for _, fieldError := range validationError.Errors {
+		fields = append(fields, fieldError.Field)
+	}
+
+	return fields
+}
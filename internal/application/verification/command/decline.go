@@ -2,17 +2,23 @@ package command
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
+	"unicode/utf8"
 
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/service"
 )
 
-const DeclineVerificationCommandType bus.CommandType = "decline.verification.command"
+const (
+	DeclineVerificationCommandType bus.CommandType = "decline.verification.command"
+	declineReasonMinLength                         = 5
+)
 
 // DeclineVerificationCommand is the command dispatched to decline verification.
 type DeclineVerificationCommand struct {
 	uuid, declineReason string
+	actor               string
 }
 
 // NewDeclineVerificationCommand creates a new DeclineVerificationCommand.
@@ -23,11 +29,70 @@ func NewDeclineVerificationCommand(UUID, declineReason string) DeclineVerificati
 	}
 }
 
+// WithActor returns copy of DeclineVerificationCommand initiated by actor, e.g. support agent or "system:expiry".
+func (c DeclineVerificationCommand) WithActor(actor string) DeclineVerificationCommand {
+	c.actor = actor
+
+	return c
+}
+
+// Actor implements bus.ActorAware interface.
+func (c DeclineVerificationCommand) Actor() string {
+	return c.actor
+}
+
 // Type implements bus.Command interface.
 func (c DeclineVerificationCommand) Type() bus.CommandType {
 	return DeclineVerificationCommandType
 }
 
+// AggregateID implements bus.AggregateAware interface.
+func (c DeclineVerificationCommand) AggregateID() string {
+	return c.uuid
+}
+
+// declineVerificationPayload represents DeclineVerificationCommand JSON structure.
+type declineVerificationPayload struct {
+	UUID          string `json:"uuid"`
+	DeclineReason string `json:"declineReason"`
+	Actor         string `json:"actor,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (c DeclineVerificationCommand) MarshalJSON() ([]byte, error) {
+	return json.Marshal(declineVerificationPayload{UUID: c.uuid, DeclineReason: c.declineReason, Actor: c.actor})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (c *DeclineVerificationCommand) UnmarshalJSON(data []byte) error {
+	var payload declineVerificationPayload
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*c = NewDeclineVerificationCommand(payload.UUID, payload.DeclineReason).WithActor(payload.Actor)
+
+	return nil
+}
+
+// Validate implements bus.Validatable interface.
+func (c DeclineVerificationCommand) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := aggregate.NewVerificationUUID(c.uuid); err != nil {
+		validationError.Add("uuid", err)
+	}
+
+	if _, err := aggregate.NewVerificationDeclineReason(c.declineReason); err != nil {
+		validationError.Add("declineReason", err)
+	} else if utf8.RuneCountInString(c.declineReason) < declineReasonMinLength {
+		validationError.Addf("declineReason", bus.ErrFieldTooShort, "must be at least %d characters long", declineReasonMinLength)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
 // DeclineVerificationCommandHandler is the DeclineVerificationCommand handler.
 type DeclineVerificationCommandHandler struct {
 	declineVerificationService service.DeclineVerificationService
@@ -40,16 +105,12 @@ func NewDeclineVerificationCommandHandler(declineVerificationService service.Dec
 	}
 }
 
-// Handle implements the bus.CommandHandler interface.
-func (h DeclineVerificationCommandHandler) Handle(ctx context.Context, cmd bus.Command) error {
-	declineVerificationCommand, ok := cmd.(DeclineVerificationCommand)
-	if !ok {
-		return fmt.Errorf("command type %s: %w", cmd.Type(), bus.ErrUnexpectedCommand)
-	}
-
+// Handle implements the bus.TypedCommandHandler interface.
+func (h DeclineVerificationCommandHandler) Handle(ctx context.Context, declineVerificationCommand DeclineVerificationCommand) error {
 	return h.declineVerificationService.Decline(
 		ctx,
 		declineVerificationCommand.uuid,
 		declineVerificationCommand.declineReason,
+		declineVerificationCommand.actor,
 	)
 }
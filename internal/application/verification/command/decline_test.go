@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/service"
@@ -22,15 +23,17 @@ func TestHandleUnsupportedDeclineVerificationCommandError(t *testing.T) {
 	unsupportedCommand.On("Type").Return(unsupportedCommandType)
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 
 	// act
-	declineVerificationService := service.NewDeclineVerificationService(verificationRepositoryMock)
+	declineVerificationService := service.NewDeclineVerificationService(verificationRepositoryMock, historyRepositoryMock)
 
-	declineVerificationCommandHandler := NewDeclineVerificationCommandHandler(declineVerificationService)
+	declineVerificationCommandHandler := bus.NewCommandHandler[DeclineVerificationCommand](NewDeclineVerificationCommandHandler(declineVerificationService))
 	err := declineVerificationCommandHandler.Handle(context.Background(), unsupportedCommand)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, bus.ErrUnexpectedCommand)
 }
 
@@ -46,17 +49,33 @@ func TestHandleDeclineVerificationCommandSuccess(t *testing.T) {
 	declineVerificationCommand := NewDeclineVerificationCommand(verification.UUID().Value(), declineReason)
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(verification, nil)
 	verificationRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	historyRepositoryMock.On("Add", mock.Anything, mock.Anything).Return(nil)
 
 	// act
-	declineVerificationService := service.NewDeclineVerificationService(verificationRepositoryMock)
+	declineVerificationService := service.NewDeclineVerificationService(verificationRepositoryMock, historyRepositoryMock)
 
 	declineVerificationCommandHandler := NewDeclineVerificationCommandHandler(declineVerificationService)
 	err := declineVerificationCommandHandler.Handle(context.Background(), declineVerificationCommand)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.NoError(t, err)
 	assert.Equal(t, aggregate.Declined, verification.Status().Value())
 }
+
+func TestDeclineVerificationCommandValidationError(t *testing.T) {
+	// assign
+	declineVerificationCommand := NewDeclineVerificationCommand("invalidUUID", "Bad")
+
+	// act
+	err := declineVerificationCommand.Validate()
+
+	// assert
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Equal(t, []string{"uuid", "declineReason"}, fieldNames(validationError))
+}
@@ -0,0 +1,348 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/utils"
+)
+
+var (
+	ErrBrokerClosed   = errors.New("verification events broker is closed")
+	ErrSlowSubscriber = errors.New("verification events subscriber is too slow, events buffer is full")
+)
+
+// Event represents verification state change: creation or status transition recorded to verification history.
+type Event struct {
+	Position         Position
+	VerificationUUID string
+	Kind             string
+	Status           string
+	Reason           string
+	Actor            string
+	OccurredAt       time.Time
+}
+
+// Filter selects streamed events. Empty fields match any event.
+type Filter struct {
+	VerificationUUID string
+	Kind             string
+	Statuses         []string
+}
+
+// Matches reports whether event passes filter.
+func (f Filter) Matches(event Event) bool {
+	if f.VerificationUUID != "" && f.VerificationUUID != event.VerificationUUID {
+		return false
+	}
+
+	if f.Kind != "" && f.Kind != event.Kind {
+		return false
+	}
+
+	return len(f.Statuses) == 0 || utils.Contains(event.Status, f.Statuses)
+}
+
+// Store defines the expected behaviour for a persisted verification change sequence.
+type Store interface {
+	// Head returns Position of the last event, zero Position when there are no events.
+	Head(ctx context.Context) (Position, error)
+	// After returns at most limit events matching filter following position in sequence order.
+	// Events of unfinished transactions are held back, so later calls never return events
+	// preceding already returned ones.
+	After(ctx context.Context, position Position, filter Filter, limit uint) ([]Event, error)
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=Store --structname=VerificationEventStore --filename=verification_event_store.go
+
+// Sink receives streamed events, e.g. writes them to HTTP response.
+type Sink interface {
+	// Open is called once stream is established, before any event is sent.
+	Open() error
+	Send(event Event) error
+	// Heartbeat is called periodically, so idle connection is not closed by proxies.
+	Heartbeat() error
+}
+
+// Options represents Broker settings.
+type Options struct {
+	PollInterval      time.Duration
+	HeartbeatInterval time.Duration
+	// BatchSize limits the number of events read from Store at once.
+	BatchSize uint
+	// BufferSize is the number of events subscription may lag behind before it is dropped.
+	BufferSize uint
+}
+
+// Broker polls Store for new events and fans them out to stream subscriptions.
+// A single poller serves all streams of application instance, so database load does not depend on number of clients.
+type Broker struct {
+	store         Store
+	options       Options
+	mu            sync.Mutex
+	head          Position
+	subscriptions map[*subscription]struct{}
+	closed        bool
+	cancel        context.CancelFunc
+	done          chan struct{}
+	closeOnce     sync.Once
+}
+
+// subscription receives live events matching filter following start Position.
+type subscription struct {
+	filter Filter
+	start  Position
+	events chan Event
+	err    error
+}
+
+// NewBroker creates a new Broker. Start must be called before streaming.
+func NewBroker(store Store, options Options) *Broker {
+	return &Broker{
+		store:         store,
+		options:       options,
+		subscriptions: make(map[*subscription]struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+// Start reads the current head of change sequence and starts polling Store for newer events in background.
+func (b *Broker) Start(ctx context.Context) error {
+	head, err := b.store.Head(ctx)
+	if err != nil {
+		return err
+	}
+
+	pollCtx, cancel := context.WithCancel(context.Background())
+
+	b.mu.Lock()
+	b.head = head
+	b.cancel = cancel
+	b.mu.Unlock()
+
+	go b.poll(pollCtx)
+
+	return nil
+}
+
+// Close stops polling and ends all streams. It is called when server shutdown starts,
+// because open streams would otherwise keep server waiting for their connections to become idle.
+func (b *Broker) Close() {
+	b.closeOnce.Do(func() {
+		b.mu.Lock()
+		b.closed = true
+
+		for s := range b.subscriptions {
+			b.unsubscribe(s, ErrBrokerClosed)
+		}
+
+		cancel := b.cancel
+		b.mu.Unlock()
+
+		if cancel == nil {
+			close(b.done)
+
+			return
+		}
+
+		cancel()
+		<-b.done
+	})
+}
+
+// Stream sends events matching filter to sink until ctx is done or Broker is closed and returns the number of sent events.
+// Events following from are replayed from Store first, nil from streams only events recorded after the call.
+// Replay ends at the head subscription started at and live events continue from there, so none is lost or repeated.
+func (b *Broker) Stream(ctx context.Context, from *Position, filter Filter, sink Sink) (uint, error) {
+	s, err := b.subscribe(filter)
+	if err != nil {
+		return 0, err
+	}
+	defer b.remove(s)
+
+	if err = sink.Open(); err != nil {
+		return 0, err
+	}
+
+	last := s.start
+
+	var sent uint
+
+	if from != nil {
+		last, sent, err = b.replay(ctx, *from, s.start, filter, sink)
+		if err != nil {
+			return sent, err
+		}
+	}
+
+	heartbeat := time.NewTicker(b.options.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return sent, nil
+		case event, ok := <-s.events:
+			if !ok {
+				if errors.Is(s.err, ErrBrokerClosed) {
+					return sent, nil
+				}
+
+				return sent, s.err
+			}
+
+			// client resumed from position this instance has not polled yet
+			if !event.Position.After(last) {
+				continue
+			}
+
+			if err := sink.Send(event); err != nil {
+				return sent, err
+			}
+
+			last = event.Position
+			sent++
+		case <-heartbeat.C:
+			if err := sink.Heartbeat(); err != nil {
+				return sent, err
+			}
+		}
+	}
+}
+
+// replay sends stored events matching filter following from up to until and returns the position stream continues from.
+func (b *Broker) replay(ctx context.Context, from, until Position, filter Filter, sink Sink) (Position, uint, error) {
+	last := from
+
+	var sent uint
+
+	for until.After(last) {
+		events, err := b.store.After(ctx, last, filter, b.options.BatchSize)
+		if err != nil {
+			return last, sent, err
+		}
+
+		for _, event := range events {
+			if event.Position.After(until) {
+				return until, sent, nil
+			}
+
+			if err := sink.Send(event); err != nil {
+				return last, sent, err
+			}
+
+			last = event.Position
+			sent++
+		}
+
+		if uint(len(events)) < b.options.BatchSize {
+			return until, sent, nil
+		}
+	}
+
+	return last, sent, nil
+}
+
+// subscribe registers subscription receiving events matching filter recorded after the current head.
+func (b *Broker) subscribe(filter Filter) (*subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrBrokerClosed
+	}
+
+	s := &subscription{
+		filter: filter,
+		start:  b.head,
+		events: make(chan Event, b.options.BufferSize),
+	}
+	b.subscriptions[s] = struct{}{}
+
+	return s, nil
+}
+
+// remove unregisters subscription of finished stream.
+func (b *Broker) remove(s *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.unsubscribe(s, nil)
+}
+
+// unsubscribe unregisters subscription and closes its events channel with err. Caller must hold the lock.
+func (b *Broker) unsubscribe(s *subscription, err error) {
+	if _, ok := b.subscriptions[s]; !ok {
+		return
+	}
+
+	delete(b.subscriptions, s)
+
+	s.err = err
+	close(s.events)
+}
+
+// poll publishes new events every poll interval until ctx is cancelled.
+func (b *Broker) poll(ctx context.Context) {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.fetch(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("verification events polling failed: %s", err)
+			}
+		}
+	}
+}
+
+// fetch reads all events following head from Store and publishes them.
+func (b *Broker) fetch(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		head := b.head
+		b.mu.Unlock()
+
+		events, err := b.store.After(ctx, head, Filter{}, b.options.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		b.publish(events)
+
+		if uint(len(events)) < b.options.BatchSize {
+			return nil
+		}
+	}
+}
+
+// publish moves head past events and sends them to matching subscriptions.
+// Subscription which buffer is full is dropped instead of blocking the others, its client resumes from the last received event.
+func (b *Broker) publish(events []Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, event := range events {
+		b.head = event.Position
+
+		for s := range b.subscriptions {
+			if !s.filter.Matches(event) {
+				continue
+			}
+
+			select {
+			case s.events <- event:
+			default:
+				b.unsubscribe(s, ErrSlowSubscriber)
+			}
+		}
+	}
+}
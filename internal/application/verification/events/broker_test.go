@@ -0,0 +1,265 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUUID      = "0b7e8d47-0a4e-4c4a-9a59-3f4f7e0f3b6b"
+	otherTestUUID = "5c8f0e9a-1b2c-4d3e-8f4a-6b7c8d9e0f1a"
+)
+
+var testOptions = Options{
+	PollInterval:      time.Millisecond,
+	HeartbeatInterval: time.Hour,
+	BatchSize:         2,
+	BufferSize:        10,
+}
+
+// memoryStore is Store keeping events in memory in sequence order.
+type memoryStore struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (s *memoryStore) Head(context.Context) (Position, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.events) == 0 {
+		return Position{}, nil
+	}
+
+	return s.events[len(s.events)-1].Position, nil
+}
+
+func (s *memoryStore) After(_ context.Context, position Position, filter Filter, limit uint) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []Event
+
+	for _, event := range s.events {
+		if event.Position.After(position) && filter.Matches(event) && uint(len(events)) < limit {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *memoryStore) add(events ...Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, events...)
+}
+
+// recordingSink is Sink passing sent events to channel.
+type recordingSink struct {
+	opened chan struct{}
+	events chan Event
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{opened: make(chan struct{}), events: make(chan Event, 100)}
+}
+
+func (s *recordingSink) Open() error {
+	close(s.opened)
+
+	return nil
+}
+
+func (s *recordingSink) Send(event Event) error {
+	s.events <- event
+
+	return nil
+}
+
+func (s *recordingSink) Heartbeat() error { return nil }
+
+// receive returns the next n events sent to sink.
+func (s *recordingSink) receive(t *testing.T, n int) []Event {
+	var events []Event
+
+	for len(events) < n {
+		select {
+		case event := <-s.events:
+			events = append(events, event)
+		case <-time.After(time.Second):
+			t.Fatalf("received %d events of %d", len(events), n)
+		}
+	}
+
+	return events
+}
+
+func newEvent(transaction, sequence uint64, uuid, status string) Event {
+	return Event{
+		Position:         Position{Transaction: transaction, Sequence: sequence},
+		VerificationUUID: uuid,
+		Status:           status,
+	}
+}
+
+// streamInBackground starts stream and returns channel receiving its result.
+func streamInBackground(ctx context.Context, broker *Broker, from *Position, filter Filter, sink Sink) chan error {
+	result := make(chan error, 1)
+
+	go func() {
+		_, err := broker.Stream(ctx, from, filter, sink)
+		result <- err
+	}()
+
+	return result
+}
+
+func TestBrokerReplaysStoredEventsThenStreamsNewOnes(t *testing.T) {
+	// assign
+	store := &memoryStore{}
+	store.add(
+		newEvent(1, 1, testUUID, "draft"),
+		newEvent(2, 2, otherTestUUID, "draft"),
+		newEvent(3, 3, testUUID, "approved"),
+	)
+
+	broker := NewBroker(store, testOptions)
+	require.NoError(t, broker.Start(context.Background()))
+	defer broker.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := newRecordingSink()
+
+	// act
+	result := streamInBackground(ctx, broker, &Position{}, Filter{VerificationUUID: testUUID}, sink)
+	replayed := sink.receive(t, 2)
+
+	store.add(newEvent(4, 4, otherTestUUID, "cancelled"), newEvent(5, 5, testUUID, "declined"))
+	live := sink.receive(t, 1)
+
+	cancel()
+
+	// assert
+	assert.NoError(t, <-result)
+	assert.Equal(t, []Event{newEvent(1, 1, testUUID, "draft"), newEvent(3, 3, testUUID, "approved")}, replayed)
+	assert.Equal(t, []Event{newEvent(5, 5, testUUID, "declined")}, live)
+	assert.Empty(t, sink.events)
+}
+
+func TestBrokerResumesAfterPosition(t *testing.T) {
+	// assign
+	store := &memoryStore{}
+	store.add(newEvent(1, 1, testUUID, "draft"), newEvent(2, 2, testUUID, "approved"), newEvent(3, 3, otherTestUUID, "draft"))
+
+	broker := NewBroker(store, testOptions)
+	require.NoError(t, broker.Start(context.Background()))
+	defer broker.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := newRecordingSink()
+
+	// act
+	result := streamInBackground(ctx, broker, &Position{Transaction: 1, Sequence: 1}, Filter{}, sink)
+	events := sink.receive(t, 2)
+
+	cancel()
+
+	// assert
+	assert.NoError(t, <-result)
+	assert.Equal(t, []Event{newEvent(2, 2, testUUID, "approved"), newEvent(3, 3, otherTestUUID, "draft")}, events)
+}
+
+func TestBrokerStreamsOnlyNewEventsWithoutPosition(t *testing.T) {
+	// assign
+	store := &memoryStore{}
+	store.add(newEvent(1, 1, testUUID, "draft"))
+
+	broker := NewBroker(store, testOptions)
+	require.NoError(t, broker.Start(context.Background()))
+	defer broker.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := newRecordingSink()
+
+	// act
+	result := streamInBackground(ctx, broker, nil, Filter{Statuses: []string{"approved", "declined"}}, sink)
+	<-sink.opened
+
+	store.add(newEvent(2, 2, otherTestUUID, "draft"), newEvent(3, 3, otherTestUUID, "approved"))
+	events := sink.receive(t, 1)
+
+	cancel()
+
+	// assert
+	assert.NoError(t, <-result)
+	assert.Equal(t, []Event{newEvent(3, 3, otherTestUUID, "approved")}, events)
+}
+
+func TestBrokerCloseEndsStreams(t *testing.T) {
+	// assign
+	broker := NewBroker(&memoryStore{}, testOptions)
+	require.NoError(t, broker.Start(context.Background()))
+
+	sink := newRecordingSink()
+	result := streamInBackground(context.Background(), broker, nil, Filter{}, sink)
+	<-sink.opened
+
+	// act
+	broker.Close()
+
+	// assert
+	select {
+	case err := <-result:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("stream is not ended by broker close")
+	}
+
+	_, err := broker.Stream(context.Background(), nil, Filter{}, newRecordingSink())
+	assert.ErrorIs(t, err, ErrBrokerClosed)
+}
+
+func TestBrokerDropsSlowSubscriber(t *testing.T) {
+	// assign
+	broker := NewBroker(&memoryStore{}, Options{BatchSize: 2, BufferSize: 1})
+
+	s, err := broker.subscribe(Filter{})
+	require.NoError(t, err)
+
+	// act
+	broker.publish([]Event{newEvent(1, 1, testUUID, "draft"), newEvent(2, 2, testUUID, "approved")})
+
+	// assert
+	assert.Equal(t, newEvent(1, 1, testUUID, "draft"), <-s.events)
+
+	_, ok := <-s.events
+	assert.False(t, ok)
+	assert.ErrorIs(t, s.err, ErrSlowSubscriber)
+	assert.Empty(t, broker.subscriptions)
+	assert.Equal(t, Position{Transaction: 2, Sequence: 2}, broker.head)
+}
+
+func TestFilterMatches(t *testing.T) {
+	// assign
+	event := Event{VerificationUUID: testUUID, Kind: "identity", Status: "approved"}
+
+	// assert
+	assert.True(t, Filter{}.Matches(event))
+	assert.True(t, Filter{VerificationUUID: testUUID, Kind: "identity", Statuses: []string{"declined", "approved"}}.Matches(event))
+	assert.False(t, Filter{VerificationUUID: otherTestUUID}.Matches(event))
+	assert.False(t, Filter{Kind: "document"}.Matches(event))
+	assert.False(t, Filter{Statuses: []string{"declined"}}.Matches(event))
+}
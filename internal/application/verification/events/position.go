@@ -0,0 +1,53 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPosition = errors.New("invalid event position")
+
+// Position is the place of Event in persisted verification change sequence.
+// Events are ordered by Transaction which recorded them and then by Sequence number within it,
+// so events of transactions committed later never appear before already delivered ones.
+// Zero Position precedes all events.
+type Position struct {
+	Transaction uint64
+	Sequence    uint64
+}
+
+// ParsePosition parses Position from its String representation, e.g. Last-Event-ID header value.
+func ParsePosition(value string) (Position, error) {
+	transaction, sequence, found := strings.Cut(value, "-")
+	if !found {
+		return Position{}, fmt.Errorf("%w: %s", ErrInvalidPosition, value)
+	}
+
+	transactionValue, err := strconv.ParseUint(transaction, 10, 64)
+	if err != nil {
+		return Position{}, fmt.Errorf("%w: %s", ErrInvalidPosition, value)
+	}
+
+	sequenceValue, err := strconv.ParseUint(sequence, 10, 64)
+	if err != nil {
+		return Position{}, fmt.Errorf("%w: %s", ErrInvalidPosition, value)
+	}
+
+	return Position{Transaction: transactionValue, Sequence: sequenceValue}, nil
+}
+
+// String returns Position representation used as event id, e.g. "7342-1519".
+func (p Position) String() string {
+	return fmt.Sprintf("%d-%d", p.Transaction, p.Sequence)
+}
+
+// After reports whether p follows other in change sequence.
+func (p Position) After(other Position) bool {
+	if p.Transaction != other.Transaction {
+		return p.Transaction > other.Transaction
+	}
+
+	return p.Sequence > other.Sequence
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePosition(t *testing.T) {
+	// act
+	position, err := ParsePosition("7342-1519")
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, Position{Transaction: 7342, Sequence: 1519}, position)
+	assert.Equal(t, "7342-1519", position.String())
+}
+
+func TestParseInvalidPosition(t *testing.T) {
+	for _, value := range []string{"", "1519", "a-1", "1-b", "-1-2", "1-2-3"} {
+		// act
+		_, err := ParsePosition(value)
+
+		// assert
+		assert.ErrorIs(t, err, ErrInvalidPosition, value)
+	}
+}
+
+func TestPositionAfter(t *testing.T) {
+	// assign
+	position := Position{Transaction: 10, Sequence: 5}
+
+	// assert
+	assert.True(t, position.After(Position{}))
+	assert.True(t, position.After(Position{Transaction: 10, Sequence: 4}))
+	assert.True(t, position.After(Position{Transaction: 9, Sequence: 7}), "later transaction follows greater sequence")
+	assert.False(t, position.After(position))
+	assert.False(t, position.After(Position{Transaction: 11, Sequence: 1}))
+}
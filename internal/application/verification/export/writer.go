@@ -0,0 +1,189 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+const (
+	ColumnID            = "id"
+	ColumnUUID          = "uuid"
+	ColumnKind          = "kind"
+	ColumnDescription   = "description"
+	ColumnStatus        = "status"
+	ColumnDeclineReason = "declineReason"
+	ColumnCreatedAt     = "createdAt"
+	ColumnDecidedAt     = "decidedAt"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown export format")
+	ErrUnknownColumn = errors.New("unknown export column")
+	ErrNoColumns     = errors.New("at least one export column is required")
+)
+
+// Columns lists exportable verification columns in default order.
+var Columns = []string{
+	ColumnID,
+	ColumnUUID,
+	ColumnKind,
+	ColumnDescription,
+	ColumnStatus,
+	ColumnDeclineReason,
+	ColumnCreatedAt,
+	ColumnDecidedAt,
+}
+
+// columnValues extracts exported column value from verification. Zero dates are exported as nil.
+var columnValues = map[string]func(*aggregate.Verification) any{
+	ColumnID:            func(v *aggregate.Verification) any { return v.ID().Value() },
+	ColumnUUID:          func(v *aggregate.Verification) any { return v.UUID().Value() },
+	ColumnKind:          func(v *aggregate.Verification) any { return v.Kind().Value() },
+	ColumnDescription:   func(v *aggregate.Verification) any { return v.Description().Value() },
+	ColumnStatus:        func(v *aggregate.Verification) any { return v.Status().Value() },
+	ColumnDeclineReason: func(v *aggregate.Verification) any { return v.DeclineReason().Value() },
+	ColumnCreatedAt:     func(v *aggregate.Verification) any { return timeValue(v.CreatedAt()) },
+	ColumnDecidedAt:     func(v *aggregate.Verification) any { return timeValue(v.DecidedAt()) },
+}
+
+// Writer encodes verifications one by one to underlying io.Writer.
+type Writer interface {
+	Write(verification *aggregate.Verification) error
+	// Flush writes buffered data to underlying io.Writer.
+	Flush() error
+}
+
+// ValidateFormat checks format is FormatCSV or FormatNDJSON.
+func ValidateFormat(format string) error {
+	if format != FormatCSV && format != FormatNDJSON {
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+
+	return nil
+}
+
+// ValidateColumns checks columns are non-empty subset of Columns.
+func ValidateColumns(columns []string) error {
+	if len(columns) == 0 {
+		return ErrNoColumns
+	}
+
+	for _, column := range columns {
+		if _, ok := columnValues[column]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownColumn, column)
+		}
+	}
+
+	return nil
+}
+
+// NewWriter creates Writer of format encoding given columns. CSV header row is written immediately.
+func NewWriter(format string, w io.Writer, columns []string) (Writer, error) {
+	if err := ValidateFormat(format); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateColumns(columns); err != nil {
+		return nil, err
+	}
+
+	if format == FormatNDJSON {
+		return &ndjsonWriter{encoder: json.NewEncoder(w), columns: columns}, nil
+	}
+
+	writer := &csvWriter{writer: csv.NewWriter(w), columns: columns, record: make([]string, len(columns))}
+	if err := writer.writer.Write(columns); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// csvWriter is the Writer of comma separated values with header row.
+type csvWriter struct {
+	writer  *csv.Writer
+	columns []string
+	record  []string
+}
+
+// Write implements Writer interface.
+func (w *csvWriter) Write(verification *aggregate.Verification) error {
+	for i, column := range w.columns {
+		switch value := columnValues[column](verification).(type) {
+		case nil:
+			w.record[i] = ""
+		case string:
+			w.record[i] = neutralizeFormula(value)
+		case uint32:
+			w.record[i] = strconv.FormatUint(uint64(value), 10)
+		default:
+			w.record[i] = fmt.Sprint(value)
+		}
+	}
+
+	return w.writer.Write(w.record)
+}
+
+// Flush implements Writer interface.
+func (w *csvWriter) Flush() error {
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
+
+// formulaPrefixes start cells that spreadsheet applications evaluate as formulas.
+const formulaPrefixes = "=+-@\t\r"
+
+// neutralizeFormula prefixes text cell starting like a formula with a single quote,
+// so spreadsheet applications display user provided text instead of evaluating it.
+func neutralizeFormula(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+// ndjsonWriter is the Writer of newline delimited JSON objects.
+type ndjsonWriter struct {
+	encoder *json.Encoder
+	columns []string
+}
+
+// Write implements Writer interface.
+func (w *ndjsonWriter) Write(verification *aggregate.Verification) error {
+	object := make(map[string]any, len(w.columns))
+
+	for _, column := range w.columns {
+		object[column] = columnValues[column](verification)
+	}
+
+	return w.encoder.Encode(object)
+}
+
+// Flush implements Writer interface. Objects are not buffered.
+func (w *ndjsonWriter) Flush() error {
+	return nil
+}
+
+// timeValue formats non-zero time as RFC 3339 string.
+func timeValue(value time.Time) any {
+	if value.IsZero() {
+		return nil
+	}
+
+	return value.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,128 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+func newTestVerification(t *testing.T) *aggregate.Verification {
+	t.Helper()
+
+	verification, err := aggregate.NewVerification(
+		"0b7e8d47-0a4e-4c4a-9a59-3f4f7e0f3b6b",
+		aggregate.Document,
+		"Passport, \"front\" page",
+	)
+	require.NoError(t, err)
+
+	verification.WithID(7)
+	verification.WithCreatedAt(time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, verification.Decline("Blurry photo"))
+	verification.WithDecidedAt(time.Date(2023, 3, 2, 12, 30, 0, 0, time.UTC))
+
+	return verification
+}
+
+func TestCSVWriter(t *testing.T) {
+	// assign
+	var out bytes.Buffer
+
+	writer, err := NewWriter(FormatCSV, &out, []string{ColumnID, ColumnDescription, ColumnStatus, ColumnDecidedAt})
+	require.NoError(t, err)
+
+	// act
+	writeErr := writer.Write(newTestVerification(t))
+	flushErr := writer.Flush()
+
+	// assert
+	require.NoError(t, writeErr)
+	require.NoError(t, flushErr)
+	assert.Equal(
+		t,
+		"id,description,status,decidedAt\n7,\"Passport, \"\"front\"\" page\",declined,2023-03-02T12:30:00Z\n",
+		out.String(),
+	)
+}
+
+func TestCSVWriterNeutralizesFormulas(t *testing.T) {
+	// assign
+	var out bytes.Buffer
+
+	verification, err := aggregate.NewVerification(
+		"0b7e8d47-0a4e-4c4a-9a59-3f4f7e0f3b6b",
+		aggregate.Document,
+		"=HYPERLINK(\"http://example.com\")",
+	)
+	require.NoError(t, err)
+	require.NoError(t, verification.Decline("@SUM(A1:A2)"))
+
+	writer, err := NewWriter(FormatCSV, &out, []string{ColumnDescription, ColumnDeclineReason})
+	require.NoError(t, err)
+
+	// act
+	require.NoError(t, writer.Write(verification))
+	require.NoError(t, writer.Flush())
+
+	// assert
+	assert.Equal(
+		t,
+		"description,declineReason\n\"'=HYPERLINK(\"\"http://example.com\"\")\",'@SUM(A1:A2)\n",
+		out.String(),
+	)
+}
+
+func TestNeutralizeFormula(t *testing.T) {
+	for value, expected := range map[string]string{
+		"":             "",
+		"Blurry photo": "Blurry photo",
+		"=1+1":         "'=1+1",
+		"+1":           "'+1",
+		"-1":           "'-1",
+		"@SUM(A1)":     "'@SUM(A1)",
+		"\t=1":         "'\t=1",
+		"\r=1":         "'\r=1",
+	} {
+		assert.Equal(t, expected, neutralizeFormula(value), value)
+	}
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	// assign
+	var out bytes.Buffer
+
+	verification, err := aggregate.NewVerification("0b7e8d47-0a4e-4c4a-9a59-3f4f7e0f3b6b", aggregate.Identity, "Selfie")
+	require.NoError(t, err)
+
+	writer, err := NewWriter(FormatNDJSON, &out, []string{ColumnUUID, ColumnKind, ColumnDecidedAt})
+	require.NoError(t, err)
+
+	// act
+	require.NoError(t, writer.Write(newTestVerification(t)))
+	require.NoError(t, writer.Write(verification))
+	require.NoError(t, writer.Flush())
+
+	// assert
+	assert.Equal(
+		t,
+		`{"decidedAt":"2023-03-02T12:30:00Z","kind":"document","uuid":"0b7e8d47-0a4e-4c4a-9a59-3f4f7e0f3b6b"}`+"\n"+
+			`{"decidedAt":null,"kind":"identity","uuid":"0b7e8d47-0a4e-4c4a-9a59-3f4f7e0f3b6b"}`+"\n",
+		out.String(),
+	)
+}
+
+func TestNewWriterErrors(t *testing.T) {
+	// act
+	_, formatErr := NewWriter("xml", &bytes.Buffer{}, Columns)
+	_, columnErr := NewWriter(FormatCSV, &bytes.Buffer{}, []string{ColumnUUID, "secret"})
+	_, noColumnsErr := NewWriter(FormatNDJSON, &bytes.Buffer{}, nil)
+
+	// assert
+	assert.ErrorIs(t, formatErr, ErrUnknownFormat)
+	assert.ErrorIs(t, columnErr, ErrUnknownColumn)
+	assert.ErrorIs(t, noColumnsErr, ErrNoColumns)
+}
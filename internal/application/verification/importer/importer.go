@@ -0,0 +1,192 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+// Actor is recorded in history of imported verifications.
+const Actor = aggregate.SystemActorPrefix + "import"
+
+// DefaultBatchSize is the number of verifications stored at once when Options.BatchSize is not set.
+const DefaultBatchSize = 1000
+
+var (
+	ErrDuplicateUUID = errors.New("verification uuid is repeated in import file")
+	ErrImportFailed  = errors.New("verifications import failed")
+)
+
+// Repository defines storage of imported verifications.
+type Repository interface {
+	// Existing returns those of uuids which are already stored.
+	Existing(ctx context.Context, uuids []string) (map[string]struct{}, error)
+	// AddBatch stores verifications at once along with their history made by actor.
+	AddBatch(ctx context.Context, verifications []*aggregate.Verification, actor string) error
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=Repository --structname=VerificationImportRepository --filename=verification_import_repository.go
+
+// Options configures Importer. Zero BatchSize stands for DefaultBatchSize,
+// DryRun validates file and reports rejects without storing anything.
+type Options struct {
+	BatchSize uint
+	DryRun    bool
+}
+
+// Result represents import summary. In dry run Imported is the number of verifications which would be stored.
+type Result struct {
+	Read     uint
+	Imported uint
+	Rejected uint
+}
+
+// Importer validates verifications read from import file and stores them in batches.
+type Importer struct {
+	repository Repository
+	options    Options
+}
+
+// NewImporter creates Importer.
+func NewImporter(repository Repository, options Options) *Importer {
+	if options.BatchSize == 0 {
+		options.BatchSize = DefaultBatchSize
+	}
+
+	return &Importer{repository: repository, options: options}
+}
+
+// pending represents validated verification waiting for batch store with its import file line.
+type pending struct {
+	line         int
+	verification *aggregate.Verification
+}
+
+// Import reads all records of reader, writes invalid and already stored ones to rejects and stores the rest.
+// Batches stored before a failure are kept, so import of the same file can be safely repeated:
+// stored verifications are rejected as existing.
+func (i *Importer) Import(ctx context.Context, reader Reader, rejects *RejectWriter) (Result, error) {
+	var result Result
+
+	seen := make(map[string]int)
+	batch := make([]pending, 0, i.options.BatchSize)
+
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowError *RowError
+		if errors.As(err, &rowError) {
+			result.Read++
+
+			if err := i.reject(&result, rejects, rowError.Line, "", rowError.Err); err != nil {
+				return result, err
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", ErrImportFailed, err)
+		}
+
+		result.Read++
+
+		verification, err := ToVerification(record)
+		if err != nil {
+			if err := i.reject(&result, rejects, record.Line, record.UUID, err); err != nil {
+				return result, err
+			}
+
+			continue
+		}
+
+		uuid := verification.UUID().Value()
+		if line, ok := seen[uuid]; ok {
+			if err := i.reject(&result, rejects, record.Line, uuid, fmt.Errorf("%w: first seen on line %d", ErrDuplicateUUID, line)); err != nil {
+				return result, err
+			}
+
+			continue
+		}
+
+		seen[uuid] = record.Line
+		batch = append(batch, pending{line: record.Line, verification: verification})
+
+		if uint(len(batch)) < i.options.BatchSize {
+			continue
+		}
+
+		if err := i.store(ctx, &result, rejects, batch); err != nil {
+			return result, err
+		}
+
+		batch = batch[:0]
+	}
+
+	if len(batch) > 0 {
+		if err := i.store(ctx, &result, rejects, batch); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// store rejects already existing verifications of batch and stores the rest unless it is dry run.
+func (i *Importer) store(ctx context.Context, result *Result, rejects *RejectWriter, batch []pending) error {
+	uuids := make([]string, len(batch))
+	for j, p := range batch {
+		uuids[j] = p.verification.UUID().Value()
+	}
+
+	existing, err := i.repository.Existing(ctx, uuids)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrImportFailed, err)
+	}
+
+	verifications := make([]*aggregate.Verification, 0, len(batch))
+
+	for _, p := range batch {
+		uuid := p.verification.UUID().Value()
+		if _, ok := existing[uuid]; ok {
+			if err := i.reject(result, rejects, p.line, uuid, aggregate.ErrVerificationAlreadyExists); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		verifications = append(verifications, p.verification)
+	}
+
+	if len(verifications) == 0 {
+		return nil
+	}
+
+	if !i.options.DryRun {
+		if err := i.repository.AddBatch(ctx, verifications, Actor); err != nil {
+			return fmt.Errorf("%s: %w", ErrImportFailed, err)
+		}
+	}
+
+	result.Imported += uint(len(verifications))
+
+	return nil
+}
+
+// reject writes rejected line to rejects and counts it in result.
+func (i *Importer) reject(result *Result, rejects *RejectWriter, line int, uuid string, reason error) error {
+	result.Rejected++
+
+	if err := rejects.Reject(line, uuid, reason); err != nil {
+		return fmt.Errorf("%s: %w", ErrImportFailed, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/export"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+const (
+	testFirstUUID  = "6a6a2bf1-1ba3-4a4f-a1a2-3c2a0f1e1f01"
+	testSecondUUID = "6a6a2bf1-1ba3-4a4f-a1a2-3c2a0f1e1f02"
+	testThirdUUID  = "6a6a2bf1-1ba3-4a4f-a1a2-3c2a0f1e1f03"
+)
+
+const testImportFile = "uuid,kind,description,status,declineReason,createdAt,decidedAt\n" +
+	testFirstUUID + ",identity,Selfie,approved,,2023-03-01T10:00:00Z,2023-03-02T10:00:00Z\n" +
+	testSecondUUID + ",document,Passport,declined,,2023-03-01T10:00:00Z,\n" +
+	testFirstUUID + ",identity,Selfie again,draft,,2023-03-01T10:00:00Z,\n" +
+	testSecondUUID + ",document,Passport,declined,Expired,2023-03-01T10:00:00Z,\n" +
+	testThirdUUID + ",document,ID card,draft,,2023-03-01T10:00:00Z,\n"
+
+var errTestCopyFailed = errors.New("copy failed")
+
+// uuidsOf returns uuids of verifications.
+func uuidsOf(verifications []*aggregate.Verification) []string {
+	uuids := make([]string, len(verifications))
+	for i, verification := range verifications {
+		uuids[i] = verification.UUID().Value()
+	}
+
+	return uuids
+}
+
+// matchUUIDs matches verifications batch by uuids.
+func matchUUIDs(uuids ...string) interface{} {
+	return mock.MatchedBy(func(verifications []*aggregate.Verification) bool {
+		return assert.ObjectsAreEqual(uuids, uuidsOf(verifications))
+	})
+}
+
+func newTestReader(t *testing.T) Reader {
+	reader, err := NewReader(export.FormatCSV, strings.NewReader(testImportFile))
+	require.NoError(t, err)
+
+	return reader
+}
+
+func TestImporterStoresBatchesAndWritesRejects(t *testing.T) {
+	// assign
+	repository := persistence.NewVerificationImportRepository(t)
+	repository.On("Existing", mock.Anything, []string{testFirstUUID, testSecondUUID}).
+		Return(map[string]struct{}{testFirstUUID: {}}, nil).Once()
+	repository.On("AddBatch", mock.Anything, matchUUIDs(testSecondUUID), Actor).Return(nil).Once()
+	repository.On("Existing", mock.Anything, []string{testThirdUUID}).Return(map[string]struct{}{}, nil).Once()
+	repository.On("AddBatch", mock.Anything, matchUUIDs(testThirdUUID), Actor).Return(nil).Once()
+
+	var rejects bytes.Buffer
+	rejectWriter := NewRejectWriter(&rejects)
+
+	// act
+	result, err := NewImporter(repository, Options{BatchSize: 2}).Import(context.Background(), newTestReader(t), rejectWriter)
+
+	// assert
+	require.NoError(t, err)
+	require.NoError(t, rejectWriter.Flush())
+	assert.Equal(t, Result{Read: 5, Imported: 2, Rejected: 3}, result)
+	assert.Equal(t, "line,uuid,error\n"+
+		"3,"+testSecondUUID+","+aggregate.ErrEmptyDeclineReason.Error()+"\n"+
+		"4,"+testFirstUUID+","+ErrDuplicateUUID.Error()+": first seen on line 2\n"+
+		"2,"+testFirstUUID+","+aggregate.ErrVerificationAlreadyExists.Error()+"\n", rejects.String())
+}
+
+func TestImporterDryRunStoresNothing(t *testing.T) {
+	// assign
+	repository := persistence.NewVerificationImportRepository(t)
+	repository.On("Existing", mock.Anything, []string{testFirstUUID, testSecondUUID, testThirdUUID}).
+		Return(map[string]struct{}{}, nil).Once()
+
+	// act
+	result, err := NewImporter(repository, Options{DryRun: true}).
+		Import(context.Background(), newTestReader(t), NewRejectWriter(&bytes.Buffer{}))
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, Result{Read: 5, Imported: 3, Rejected: 2}, result)
+	repository.AssertNotCalled(t, "AddBatch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImporterStopsOnStoreError(t *testing.T) {
+	// assign
+	repository := persistence.NewVerificationImportRepository(t)
+	repository.On("Existing", mock.Anything, mock.Anything).Return(map[string]struct{}{}, nil).Once()
+	repository.On("AddBatch", mock.Anything, mock.Anything, Actor).Return(errTestCopyFailed).Once()
+
+	// act
+	result, err := NewImporter(repository, Options{BatchSize: 2}).
+		Import(context.Background(), newTestReader(t), NewRejectWriter(&bytes.Buffer{}))
+
+	// assert
+	assert.ErrorIs(t, err, errTestCopyFailed)
+	assert.Contains(t, err.Error(), ErrImportFailed.Error())
+	assert.Equal(t, uint(0), result.Imported)
+}
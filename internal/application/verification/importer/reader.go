@@ -0,0 +1,173 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/export"
+)
+
+// ndjsonMaxLineSize limits single NDJSON line, longer lines fail the whole import.
+const ndjsonMaxLineSize = 1024 * 1024
+
+var (
+	ErrUnknownFormat         = errors.New("unknown import format")
+	ErrMissingRequiredColumn = errors.New("missing required column")
+	ErrMalformedRecord       = errors.New("malformed record")
+)
+
+// requiredColumns lists columns every CSV header must contain.
+var requiredColumns = []string{export.ColumnUUID, export.ColumnKind, export.ColumnDescription, export.ColumnCreatedAt}
+
+// Record represents single raw verification read from Line of import file.
+// Columns use export names, so exported files can be imported back.
+type Record struct {
+	Line          int    `json:"-"`
+	UUID          string `json:"uuid"`
+	Kind          string `json:"kind"`
+	Description   string `json:"description"`
+	Status        string `json:"status"`
+	DeclineReason string `json:"declineReason"`
+	CreatedAt     string `json:"createdAt"`
+	DecidedAt     string `json:"decidedAt"`
+}
+
+// RowError represents unreadable Line of import file. Reading can continue with the next line.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+// Error implements error interface.
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Reader reads import file record by record.
+type Reader interface {
+	// Next returns the next Record, io.EOF at the end of file or *RowError if current line can not be read.
+	Next() (*Record, error)
+}
+
+// NewReader creates Reader of format, export.FormatCSV or export.FormatNDJSON.
+// CSV header is read immediately and must contain requiredColumns, unknown columns are ignored.
+func NewReader(format string, r io.Reader) (Reader, error) {
+	switch format {
+	case export.FormatCSV:
+		return newCSVReader(r)
+	case export.FormatNDJSON:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), ndjsonMaxLineSize)
+
+		return &ndjsonReader{scanner: scanner}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// csvReader is the Reader of comma separated values with header row.
+type csvReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// newCSVReader creates csvReader and reads header row.
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: header: %s", ErrMalformedRecord, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.TrimSpace(column)] = i
+	}
+
+	for _, column := range requiredColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingRequiredColumn, column)
+		}
+	}
+
+	return &csvReader{reader: reader, columns: columns}, nil
+}
+
+// Next implements Reader interface.
+func (r *csvReader) Next() (*Record, error) {
+	values, err := r.reader.Read()
+	if err != nil {
+		var parseError *csv.ParseError
+		if errors.As(err, &parseError) {
+			return nil, &RowError{Line: parseError.StartLine, Err: fmt.Errorf("%w: %s", ErrMalformedRecord, parseError.Err)}
+		}
+
+		return nil, err
+	}
+
+	line, _ := r.reader.FieldPos(0)
+
+	return &Record{
+		Line:          line,
+		UUID:          r.value(values, export.ColumnUUID),
+		Kind:          r.value(values, export.ColumnKind),
+		Description:   r.value(values, export.ColumnDescription),
+		Status:        r.value(values, export.ColumnStatus),
+		DeclineReason: r.value(values, export.ColumnDeclineReason),
+		CreatedAt:     r.value(values, export.ColumnCreatedAt),
+		DecidedAt:     r.value(values, export.ColumnDecidedAt),
+	}, nil
+}
+
+// value returns column value of record or empty string if there is no such column.
+func (r *csvReader) value(values []string, column string) string {
+	if i, ok := r.columns[column]; ok {
+		return values[i]
+	}
+
+	return ""
+}
+
+// ndjsonReader is the Reader of newline delimited JSON objects. Blank lines are skipped.
+type ndjsonReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// Next implements Reader interface.
+func (r *ndjsonReader) Next() (*Record, error) {
+	for r.scanner.Scan() {
+		r.line++
+
+		data := r.scanner.Bytes()
+		if len(strings.TrimSpace(string(data))) == 0 {
+			continue
+		}
+
+		record := Record{Line: r.line}
+
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, &RowError{Line: r.line, Err: fmt.Errorf("%w: %s", ErrMalformedRecord, err)}
+		}
+
+		return &record, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
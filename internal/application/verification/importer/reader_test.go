@@ -0,0 +1,103 @@
+package importer
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/export"
+)
+
+// readAll returns records and row errors of reader until io.EOF.
+func readAll(t *testing.T, reader Reader) ([]*Record, []*RowError) {
+	var (
+		records   []*Record
+		rowErrors []*RowError
+	)
+
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return records, rowErrors
+		}
+
+		var rowError *RowError
+		if errors.As(err, &rowError) {
+			rowErrors = append(rowErrors, rowError)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+}
+
+func TestCSVReaderMapsColumnsByHeader(t *testing.T) {
+	// assign
+	input := "id,kind,uuid,description,createdAt\n" +
+		"1,identity," + testUUID + ",\"Selfie, front\",2023-03-01T10:00:00Z\n" +
+		"2,document\n" +
+		"3,document,other,Passport,2023-03-01T10:00:00Z\n"
+
+	reader, err := NewReader(export.FormatCSV, strings.NewReader(input))
+	require.NoError(t, err)
+
+	// act
+	records, rowErrors := readAll(t, reader)
+
+	// assert
+	require.Len(t, records, 2)
+	assert.Equal(t, &Record{
+		Line:        2,
+		UUID:        testUUID,
+		Kind:        "identity",
+		Description: "Selfie, front",
+		CreatedAt:   "2023-03-01T10:00:00Z",
+	}, records[0])
+	assert.Equal(t, 4, records[1].Line)
+	require.Len(t, rowErrors, 1)
+	assert.Equal(t, 3, rowErrors[0].Line)
+	assert.ErrorIs(t, rowErrors[0], ErrMalformedRecord)
+}
+
+func TestCSVReaderRequiresColumns(t *testing.T) {
+	// act
+	_, err := NewReader(export.FormatCSV, strings.NewReader("uuid,kind,description\n"))
+
+	// assert
+	assert.ErrorIs(t, err, ErrMissingRequiredColumn)
+	assert.Contains(t, err.Error(), export.ColumnCreatedAt)
+}
+
+func TestNDJSONReaderSkipsBlankLines(t *testing.T) {
+	// assign
+	input := `{"uuid":"` + testUUID + `","kind":"identity","status":"approved","decidedAt":null}` + "\n" +
+		"\n" +
+		"{not json}\n" +
+		`{"uuid":"other","id":3}`
+
+	reader, err := NewReader(export.FormatNDJSON, strings.NewReader(input))
+	require.NoError(t, err)
+
+	// act
+	records, rowErrors := readAll(t, reader)
+
+	// assert
+	require.Len(t, records, 2)
+	assert.Equal(t, &Record{Line: 1, UUID: testUUID, Kind: "identity", Status: "approved"}, records[0])
+	assert.Equal(t, &Record{Line: 4, UUID: "other"}, records[1])
+	require.Len(t, rowErrors, 1)
+	assert.Equal(t, 3, rowErrors[0].Line)
+}
+
+func TestNewReaderUnknownFormat(t *testing.T) {
+	// act
+	_, err := NewReader("xml", strings.NewReader(""))
+
+	// assert
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
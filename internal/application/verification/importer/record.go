@@ -0,0 +1,93 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+var (
+	ErrMissingCreatedAt        = errors.New("verification create date must not be empty")
+	ErrInvalidDate             = errors.New("date must be in RFC 3339 format")
+	ErrUnexpectedDeclineReason = errors.New("decline reason is allowed for declined verification only")
+	ErrUnexpectedDecidedAt     = errors.New("decision date is allowed for approved or declined verification only")
+	ErrDecidedBeforeCreated    = errors.New("decision date must not be before create date")
+)
+
+// ToVerification validates record and creates aggregate.Verification of its historical state.
+// Empty status stands for aggregate.Draft.
+func ToVerification(record *Record) (*aggregate.Verification, error) {
+	verification, err := aggregate.NewVerification(
+		strings.TrimSpace(record.UUID),
+		strings.TrimSpace(record.Kind),
+		record.Description,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	status := strings.TrimSpace(record.Status)
+	if status == "" {
+		status = aggregate.Draft
+	}
+
+	if err := verification.WithStatus(status); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case status == aggregate.Declined:
+		if err := verification.WithDeclineReason(record.DeclineReason); err != nil {
+			return nil, err
+		}
+	case record.DeclineReason != "":
+		return nil, ErrUnexpectedDeclineReason
+	}
+
+	if strings.TrimSpace(record.CreatedAt) == "" {
+		return nil, ErrMissingCreatedAt
+	}
+
+	createdAt, err := parseDate(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	verification.WithCreatedAt(createdAt)
+	verification.WithUpdatedAt(createdAt)
+
+	if strings.TrimSpace(record.DecidedAt) == "" {
+		return verification, nil
+	}
+
+	if status != aggregate.Approved && status != aggregate.Declined {
+		return nil, ErrUnexpectedDecidedAt
+	}
+
+	decidedAt, err := parseDate(record.DecidedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if decidedAt.Before(createdAt) {
+		return nil, ErrDecidedBeforeCreated
+	}
+
+	verification.WithDecidedAt(decidedAt)
+	verification.WithUpdatedAt(decidedAt)
+
+	return verification, nil
+}
+
+// parseDate parses RFC 3339 date and converts it to UTC.
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidDate, value)
+	}
+
+	return date.UTC(), nil
+}
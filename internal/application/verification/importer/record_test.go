@@ -0,0 +1,98 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const testUUID = "0b7e8d47-0a4e-4c4a-9a59-3f4f7e0f3b6b"
+
+func TestToVerificationRestoresHistoricalState(t *testing.T) {
+	// assign
+	record := &Record{
+		UUID:          testUUID,
+		Kind:          aggregate.Document,
+		Description:   "Passport",
+		Status:        aggregate.Declined,
+		DeclineReason: "Expired",
+		CreatedAt:     "2023-03-01T10:00:00+02:00",
+		DecidedAt:     "2023-03-02T12:30:00Z",
+	}
+
+	// act
+	verification, err := ToVerification(record)
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, testUUID, verification.UUID().Value())
+	assert.Equal(t, aggregate.Declined, verification.Status().Value())
+	assert.Equal(t, "Expired", verification.DeclineReason().Value())
+	assert.Equal(t, time.Date(2023, 3, 1, 8, 0, 0, 0, time.UTC), verification.CreatedAt())
+	assert.Equal(t, time.Date(2023, 3, 2, 12, 30, 0, 0, time.UTC), verification.DecidedAt())
+	assert.Equal(t, verification.DecidedAt(), verification.UpdatedAt())
+}
+
+func TestToVerificationDefaultsToDraft(t *testing.T) {
+	// act
+	verification, err := ToVerification(&Record{
+		UUID:        testUUID,
+		Kind:        aggregate.Identity,
+		Description: "Selfie",
+		CreatedAt:   "2023-03-01T10:00:00Z",
+	})
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, aggregate.Draft, verification.Status().Value())
+	assert.True(t, verification.DecidedAt().IsZero())
+	assert.Equal(t, verification.CreatedAt(), verification.UpdatedAt())
+}
+
+func TestToVerificationErrors(t *testing.T) {
+	valid := Record{
+		UUID:        testUUID,
+		Kind:        aggregate.Identity,
+		Description: "Selfie",
+		Status:      aggregate.Approved,
+		CreatedAt:   "2023-03-01T10:00:00Z",
+	}
+
+	tests := []struct {
+		name   string
+		modify func(record *Record)
+		err    error
+	}{
+		{name: "invalid uuid", modify: func(r *Record) { r.UUID = "1" }, err: aggregate.ErrInvalidVerificationUUID},
+		{name: "invalid kind", modify: func(r *Record) { r.Kind = "face" }, err: aggregate.ErrInvalidVerificationKind},
+		{name: "empty description", modify: func(r *Record) { r.Description = "" }, err: aggregate.ErrEmptyDescription},
+		{name: "invalid status", modify: func(r *Record) { r.Status = "pending" }, err: aggregate.ErrInvalidVerificationStatus},
+		{name: "declined without reason", modify: func(r *Record) { r.Status = aggregate.Declined }, err: aggregate.ErrEmptyDeclineReason},
+		{name: "reason of approved", modify: func(r *Record) { r.DeclineReason = "Expired" }, err: ErrUnexpectedDeclineReason},
+		{name: "missing create date", modify: func(r *Record) { r.CreatedAt = "" }, err: ErrMissingCreatedAt},
+		{name: "invalid create date", modify: func(r *Record) { r.CreatedAt = "2023-03-01" }, err: ErrInvalidDate},
+		{
+			name:   "decision date of draft",
+			modify: func(r *Record) { r.Status, r.DecidedAt = aggregate.Draft, "2023-03-02T10:00:00Z" },
+			err:    ErrUnexpectedDecidedAt,
+		},
+		{name: "decided before created", modify: func(r *Record) { r.DecidedAt = "2023-02-28T10:00:00Z" }, err: ErrDecidedBeforeCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// assign
+			record := valid
+			tt.modify(&record)
+
+			// act
+			_, err := ToVerification(&record)
+
+			// assert
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
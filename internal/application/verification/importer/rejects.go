@@ -0,0 +1,41 @@
+package importer
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// rejectsHeader is the header row of rejects file.
+var rejectsHeader = []string{"line", "uuid", "error"}
+
+// RejectWriter writes records refused by Importer as CSV rows of line number, uuid and error message.
+type RejectWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+// NewRejectWriter creates RejectWriter writing to w. Header row is written along with the first reject.
+func NewRejectWriter(w io.Writer) *RejectWriter {
+	return &RejectWriter{writer: csv.NewWriter(w)}
+}
+
+// Reject writes rejected line of import file with uuid and reason err.
+func (w *RejectWriter) Reject(line int, uuid string, err error) error {
+	if !w.headerWritten {
+		if err := w.writer.Write(rejectsHeader); err != nil {
+			return err
+		}
+
+		w.headerWritten = true
+	}
+
+	return w.writer.Write([]string{strconv.Itoa(line), uuid, err.Error()})
+}
+
+// Flush writes buffered rejects to the underlying io.Writer.
+func (w *RejectWriter) Flush() error {
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
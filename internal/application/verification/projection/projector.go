@@ -0,0 +1,61 @@
+package projection
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+// projectedCommandTypes lists commands changing verification state.
+var projectedCommandTypes = map[bus.CommandType]struct{}{
+	command.CreateVerificationCommandType:  {},
+	command.ApproveVerificationCommandType: {},
+	command.DeclineVerificationCommandType: {},
+	command.CancelVerificationCommandType:  {},
+}
+
+// VerificationViewProjector is the projection.Projection keeping readmodel.VerificationView in sync with aggregate.Verification.
+type VerificationViewProjector struct {
+	verificationRepository     aggregate.VerificationRepository
+	verificationViewRepository readmodel.Repository
+}
+
+// NewVerificationViewProjector creates a new VerificationViewProjector.
+func NewVerificationViewProjector(
+	verificationRepository aggregate.VerificationRepository,
+	verificationViewRepository readmodel.Repository,
+) VerificationViewProjector {
+	return VerificationViewProjector{
+		verificationRepository:     verificationRepository,
+		verificationViewRepository: verificationViewRepository,
+	}
+}
+
+// Project implements projection.Projection interface. Verification is reloaded from the write side,
+// so view reflects the committed state regardless of the command payload.
+func (p VerificationViewProjector) Project(ctx context.Context, projectedCommand bus.Command) error {
+	if _, ok := projectedCommandTypes[projectedCommand.Type()]; !ok {
+		return nil
+	}
+
+	aggregateAware, ok := projectedCommand.(bus.AggregateAware)
+	if !ok {
+		return nil
+	}
+
+	verificationUUID, err := aggregate.NewVerificationUUID(aggregateAware.AggregateID())
+	if err != nil {
+		return err
+	}
+
+	verification, err := p.verificationRepository.GetByUUID(ctx, verificationUUID)
+	if err != nil {
+		return err
+	}
+
+	return p.verificationViewRepository.Save(ctx, readmodel.NewVerificationView(verification, time.Now()))
+}
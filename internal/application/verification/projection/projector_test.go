@@ -0,0 +1,88 @@
+package projection
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestProjectVerificationViewSuccess(t *testing.T) {
+	// assign
+	verification, _ := aggregate.NewVerification(
+		uuid.New().String(),
+		aggregate.Identity,
+		"Fancy verification document description",
+	)
+	require.NoError(t, verification.Decline("Document is expired"))
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, verification.UUID()).Return(verification, nil)
+
+	verificationViewRepositoryMock := new(persistence.VerificationViewRepository)
+	verificationViewRepositoryMock.On("Save", mock.Anything, mock.MatchedBy(func(view *readmodel.VerificationView) bool {
+		return view.UUID == verification.UUID().Value() &&
+			view.Status == aggregate.Declined &&
+			view.DeclineReason == "Document is expired" &&
+			!view.ProjectedAt.IsZero()
+	})).Return(nil)
+
+	// act
+	projector := NewVerificationViewProjector(verificationRepositoryMock, verificationViewRepositoryMock)
+	err := projector.Project(context.Background(), command.NewDeclineVerificationCommand(verification.UUID().Value(), "Document is expired"))
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	verificationViewRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestProjectIgnoresUnrelatedCommand(t *testing.T) {
+	// assign
+	unrelatedCommand := new(mocks.Command)
+	unrelatedCommand.On("Type").Return(command.CreateVerificationCommandType + "_unrelated")
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationViewRepositoryMock := new(persistence.VerificationViewRepository)
+
+	// act
+	projector := NewVerificationViewProjector(verificationRepositoryMock, verificationViewRepositoryMock)
+	err := projector.Project(context.Background(), unrelatedCommand)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	verificationViewRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestProjectVerificationViewSaveError(t *testing.T) {
+	// assign
+	errSaveFailed := errors.New("save failed")
+	verification, _ := aggregate.NewVerification(
+		uuid.New().String(),
+		aggregate.Identity,
+		"Fancy verification document description",
+	)
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, verification.UUID()).Return(verification, nil)
+
+	verificationViewRepositoryMock := new(persistence.VerificationViewRepository)
+	verificationViewRepositoryMock.On("Save", mock.Anything, mock.Anything).Return(errSaveFailed)
+
+	// act
+	projector := NewVerificationViewProjector(verificationRepositoryMock, verificationViewRepositoryMock)
+	err := projector.Project(context.Background(), command.NewApproveVerificationCommand(verification.UUID().Value()))
+
+	// assert
+	assert.ErrorIs(t, err, errSaveFailed)
+}
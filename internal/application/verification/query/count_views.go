@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+)
+
+const CountVerificationViewsQueryType bus.QueryType = "count_views.verification.query"
+
+// CountVerificationViewsQuery is the query dispatched to count verifications per status from the read model.
+type CountVerificationViewsQuery struct {
+	bus.Returns[map[string]uint]
+	kind string
+}
+
+// NewCountVerificationViewsQuery creates a new CountVerificationViewsQuery. Empty kind is not filtered.
+func NewCountVerificationViewsQuery(kind string) CountVerificationViewsQuery {
+	return CountVerificationViewsQuery{
+		kind: kind,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q CountVerificationViewsQuery) Type() bus.QueryType {
+	return CountVerificationViewsQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q CountVerificationViewsQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	validateViewFilter(&validationError, "", q.kind)
+
+	return validationError.ErrorOrNil()
+}
+
+// CountVerificationViewsQueryHandler is the CountVerificationViewsQuery handler.
+type CountVerificationViewsQueryHandler struct {
+	verificationViewRepository readmodel.Repository
+}
+
+// NewCountVerificationViewsQueryHandler initializes a new CountVerificationViewsQueryHandler.
+func NewCountVerificationViewsQueryHandler(verificationViewRepository readmodel.Repository) CountVerificationViewsQueryHandler {
+	return CountVerificationViewsQueryHandler{
+		verificationViewRepository: verificationViewRepository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h CountVerificationViewsQueryHandler) Handle(
+	ctx context.Context,
+	countVerificationViewsQuery CountVerificationViewsQuery,
+) (map[string]uint, error) {
+	return h.verificationViewRepository.CountByStatus(ctx, readmodel.Filter{Kind: countVerificationViewsQuery.kind})
+}
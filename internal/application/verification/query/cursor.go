@@ -0,0 +1,69 @@
+package query
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+var (
+	ErrInvalidCursor    = errors.New("invalid pagination cursor")
+	ErrCursorSortChange = errors.New("pagination cursor was issued for another sort")
+)
+
+// cursorPayload represents aggregate.VerificationCursor serialized to opaque pagination cursor.
+// Sort is kept to reject cursor reused with another sort, since its position means nothing there.
+type cursorPayload struct {
+	Sort      string    `json:"s"`
+	CreatedAt time.Time `json:"c,omitempty"`
+	Value     string    `json:"v,omitempty"`
+	ID        uint32    `json:"i"`
+}
+
+// parseSort parses sort parameter, field name optionally prefixed with "-" for descending order.
+func parseSort(value string) (aggregate.VerificationSort, error) {
+	return aggregate.NewVerificationSort(strings.TrimPrefix(value, "-"), strings.HasPrefix(value, "-"))
+}
+
+// encodeCursor creates opaque pagination cursor pointing to verification.
+func encodeCursor(verification *aggregate.Verification, sort string, verificationSort aggregate.VerificationSort) string {
+	cursor := aggregate.NewVerificationCursor(verification, verificationSort)
+
+	// marshalling struct of plain fields never fails
+	payload, _ := json.Marshal(cursorPayload{
+		Sort:      sort,
+		CreatedAt: cursor.CreatedAt,
+		Value:     cursor.Value,
+		ID:        cursor.ID,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// decodeCursor restores aggregate.VerificationCursor from opaque pagination cursor issued for the same sort.
+func decodeCursor(value, sort string) (*aggregate.VerificationCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+
+	if err := json.Unmarshal(decoded, &payload); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if payload.Sort != sort {
+		return nil, ErrCursorSortChange
+	}
+
+	return &aggregate.VerificationCursor{
+		CreatedAt: payload.CreatedAt,
+		Value:     payload.Value,
+		ID:        payload.ID,
+	}, nil
+}
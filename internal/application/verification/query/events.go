@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/events"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const StreamVerificationEventsQueryType bus.QueryType = "events.verification.query"
+
+// StreamVerificationEventsFilter represents verification events stream parameters.
+// Empty UUID streams changes of all verifications, LastEventID resumes stream after the event client received last.
+type StreamVerificationEventsFilter struct {
+	UUID        string
+	Kind        string
+	Statuses    []string
+	LastEventID string
+}
+
+// StreamVerificationEventsQuery is the query dispatched to send verification status changes to sink until client disconnects.
+// It returns the number of sent events.
+type StreamVerificationEventsQuery struct {
+	bus.Returns[uint]
+	filter StreamVerificationEventsFilter
+	sink   events.Sink
+}
+
+// NewStreamVerificationEventsQuery creates a new StreamVerificationEventsQuery sending events to sink.
+func NewStreamVerificationEventsQuery(filter StreamVerificationEventsFilter, sink events.Sink) StreamVerificationEventsQuery {
+	return StreamVerificationEventsQuery{
+		filter: filter,
+		sink:   sink,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q StreamVerificationEventsQuery) Type() bus.QueryType {
+	return StreamVerificationEventsQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q StreamVerificationEventsQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if q.filter.UUID != "" {
+		if _, err := aggregate.NewVerificationUUID(q.filter.UUID); err != nil {
+			validationError.Add("uuid", err)
+		}
+	}
+
+	validateViewFilter(&validationError, "", q.filter.Kind)
+
+	for _, status := range q.filter.Statuses {
+		if _, err := aggregate.NewVerificationStatus(status); err != nil {
+			validationError.Add("status", err)
+
+			break
+		}
+	}
+
+	if q.filter.LastEventID != "" {
+		if _, err := events.ParsePosition(q.filter.LastEventID); err != nil {
+			validationError.Add("lastEventId", err)
+		}
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// StreamVerificationEventsQueryHandler is the StreamVerificationEventsQuery handler.
+type StreamVerificationEventsQueryHandler struct {
+	verificationRepository aggregate.VerificationRepository
+	broker                 *events.Broker
+}
+
+// NewStreamVerificationEventsQueryHandler initializes a new StreamVerificationEventsQueryHandler.
+func NewStreamVerificationEventsQueryHandler(
+	verificationRepository aggregate.VerificationRepository,
+	broker *events.Broker,
+) StreamVerificationEventsQueryHandler {
+	return StreamVerificationEventsQueryHandler{
+		verificationRepository: verificationRepository,
+		broker:                 broker,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface. Stream of single verification fails with not found error
+// for unknown verification and starts with its whole history, so client learns the current status at once.
+// Stream of all verifications starts with changes made after the call unless it is resumed.
+func (h StreamVerificationEventsQueryHandler) Handle(
+	ctx context.Context,
+	streamVerificationEventsQuery StreamVerificationEventsQuery,
+) (uint, error) {
+	filter := streamVerificationEventsQuery.filter
+
+	var from *events.Position
+
+	if filter.UUID != "" {
+		verificationUUID, err := aggregate.NewVerificationUUID(filter.UUID)
+		if err != nil {
+			return 0, err
+		}
+
+		if _, err := h.verificationRepository.GetByUUID(ctx, verificationUUID); err != nil {
+			return 0, err
+		}
+
+		from = &events.Position{}
+	}
+
+	if filter.LastEventID != "" {
+		position, err := events.ParsePosition(filter.LastEventID)
+		if err != nil {
+			return 0, err
+		}
+
+		from = &position
+	}
+
+	return h.broker.Stream(
+		ctx,
+		from,
+		events.Filter{VerificationUUID: filter.UUID, Kind: filter.Kind, Statuses: filter.Statuses},
+		streamVerificationEventsQuery.sink,
+	)
+}
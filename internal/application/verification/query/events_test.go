@@ -0,0 +1,163 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/events"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+// cancellingSink collects sent events and cancels stream after the first one.
+type cancellingSink struct {
+	cancel context.CancelFunc
+	events []events.Event
+}
+
+func (s *cancellingSink) Open() error { return nil }
+
+func (s *cancellingSink) Send(event events.Event) error {
+	s.events = append(s.events, event)
+	s.cancel()
+
+	return nil
+}
+
+func (s *cancellingSink) Heartbeat() error { return nil }
+
+// newTestBroker creates started events.Broker of eventStoreMock whose sequence head is head.
+// Store is not polled during test, so only replayed events are streamed.
+func newTestBroker(t *testing.T, eventStoreMock *persistence.VerificationEventStore, head events.Position) *events.Broker {
+	eventStoreMock.On("Head", mock.Anything).Return(head, nil)
+
+	broker := events.NewBroker(eventStoreMock, events.Options{
+		PollInterval:      time.Hour,
+		HeartbeatInterval: time.Hour,
+		BatchSize:         10,
+		BufferSize:        10,
+	})
+	require.NoError(t, broker.Start(context.Background()))
+	t.Cleanup(broker.Close)
+
+	return broker
+}
+
+func TestHandleStreamVerificationEventsQueryReplaysVerificationHistory(t *testing.T) {
+	// assign
+	verification, err := aggregate.NewVerification(uuid.New().String(), aggregate.Identity, "Fancy verification description")
+	require.NoError(t, err)
+
+	created := events.Event{
+		Position:         events.Position{Transaction: 7, Sequence: 3},
+		VerificationUUID: verification.UUID().Value(),
+		Status:           aggregate.Draft,
+	}
+	filter := events.Filter{VerificationUUID: verification.UUID().Value()}
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, verification.UUID()).Return(verification, nil)
+
+	eventStoreMock := new(persistence.VerificationEventStore)
+	eventStoreMock.On("After", mock.Anything, events.Position{}, filter, uint(10)).Return([]events.Event{created}, nil)
+	broker := newTestBroker(t, eventStoreMock, events.Position{Transaction: 9, Sequence: 5})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := &cancellingSink{cancel: cancel}
+
+	// act
+	streamVerificationEventsQueryHandler := NewStreamVerificationEventsQueryHandler(verificationRepositoryMock, broker)
+	sent, err := streamVerificationEventsQueryHandler.Handle(
+		ctx,
+		NewStreamVerificationEventsQuery(StreamVerificationEventsFilter{UUID: verification.UUID().Value()}, sink),
+	)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	eventStoreMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), sent)
+	assert.Equal(t, []events.Event{created}, sink.events)
+}
+
+func TestHandleStreamVerificationEventsQueryResumesAfterLastEventID(t *testing.T) {
+	// assign
+	approved := events.Event{
+		Position:         events.Position{Transaction: 8, Sequence: 4},
+		VerificationUUID: uuid.New().String(),
+		Status:           aggregate.Approved,
+	}
+	filter := events.Filter{Statuses: []string{aggregate.Approved}}
+
+	eventStoreMock := new(persistence.VerificationEventStore)
+	eventStoreMock.On("After", mock.Anything, events.Position{Transaction: 7, Sequence: 3}, filter, uint(10)).
+		Return([]events.Event{approved}, nil)
+	broker := newTestBroker(t, eventStoreMock, events.Position{Transaction: 9, Sequence: 5})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := &cancellingSink{cancel: cancel}
+
+	// act
+	streamVerificationEventsQueryHandler := NewStreamVerificationEventsQueryHandler(new(persistence.VerificationRepository), broker)
+	sent, err := streamVerificationEventsQueryHandler.Handle(
+		ctx,
+		NewStreamVerificationEventsQuery(StreamVerificationEventsFilter{
+			Statuses:    []string{aggregate.Approved},
+			LastEventID: "7-3",
+		}, sink),
+	)
+
+	// assert
+	eventStoreMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), sent)
+	assert.Equal(t, []events.Event{approved}, sink.events)
+}
+
+func TestHandleStreamVerificationEventsQueryNotFoundError(t *testing.T) {
+	// assign
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(nil, postgres.ErrVerificationNotFound)
+
+	// act
+	streamVerificationEventsQueryHandler := NewStreamVerificationEventsQueryHandler(verificationRepositoryMock, nil)
+	_, err := streamVerificationEventsQueryHandler.Handle(
+		context.Background(),
+		NewStreamVerificationEventsQuery(StreamVerificationEventsFilter{UUID: uuid.New().String()}, &cancellingSink{}),
+	)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, postgres.ErrVerificationNotFound)
+}
+
+func TestStreamVerificationEventsQueryValidationError(t *testing.T) {
+	// assign
+	streamVerificationEventsQuery := NewStreamVerificationEventsQuery(StreamVerificationEventsFilter{
+		UUID:        "invalid",
+		Kind:        "unknown",
+		Statuses:    []string{aggregate.Approved, "unknown"},
+		LastEventID: "last",
+	}, &cancellingSink{})
+
+	// act
+	err := streamVerificationEventsQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Len(t, validationError.Errors, 4)
+}
@@ -0,0 +1,131 @@
+package query
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/export"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const ExportVerificationsQueryType bus.QueryType = "export.verification.query"
+
+// ExportVerificationsFilter represents verifications export parameters, the same as of listing but without pagination.
+// Empty columns export all export.Columns.
+type ExportVerificationsFilter struct {
+	Status            string
+	Kind              string
+	ExternalReference string
+	CreatedFrom       time.Time
+	CreatedTo         time.Time
+	Sort              string
+	Format            string
+	Columns           []string
+}
+
+// ExportVerificationsQuery is the query dispatched to write all verifications matching filter to out.
+// It returns the number of exported verifications.
+type ExportVerificationsQuery struct {
+	bus.Returns[uint]
+	filter ExportVerificationsFilter
+	out    io.Writer
+}
+
+// NewExportVerificationsQuery creates a new ExportVerificationsQuery writing to out.
+func NewExportVerificationsQuery(filter ExportVerificationsFilter, out io.Writer) ExportVerificationsQuery {
+	if filter.Sort == "" {
+		filter.Sort = ListVerificationsDefaultSort
+	}
+
+	if len(filter.Columns) == 0 {
+		filter.Columns = export.Columns
+	}
+
+	return ExportVerificationsQuery{
+		filter: filter,
+		out:    out,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q ExportVerificationsQuery) Type() bus.QueryType {
+	return ExportVerificationsQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q ExportVerificationsQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	validateVerificationFilter(&validationError, q.filter.Status, q.filter.Kind, q.filter.CreatedFrom, q.filter.CreatedTo)
+
+	if _, err := parseSort(q.filter.Sort); err != nil {
+		validationError.Add("sort", err)
+	}
+
+	if err := export.ValidateFormat(q.filter.Format); err != nil {
+		validationError.Add("format", err)
+	}
+
+	if err := export.ValidateColumns(q.filter.Columns); err != nil {
+		validationError.Add("columns", err)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// ExportVerificationsQueryHandler is the ExportVerificationsQuery handler.
+type ExportVerificationsQueryHandler struct {
+	verificationRepository aggregate.VerificationRepository
+}
+
+// NewExportVerificationsQueryHandler initializes a new ExportVerificationsQueryHandler.
+func NewExportVerificationsQueryHandler(verificationRepository aggregate.VerificationRepository) ExportVerificationsQueryHandler {
+	return ExportVerificationsQueryHandler{
+		verificationRepository: verificationRepository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface. Verifications are streamed from the repository
+// to the writer one by one, so memory usage does not depend on the number of exported verifications.
+func (h ExportVerificationsQueryHandler) Handle(
+	ctx context.Context,
+	exportVerificationsQuery ExportVerificationsQuery,
+) (uint, error) {
+	filter := exportVerificationsQuery.filter
+
+	sort, err := parseSort(filter.Sort)
+	if err != nil {
+		return 0, err
+	}
+
+	writer, err := export.NewWriter(filter.Format, exportVerificationsQuery.out, filter.Columns)
+	if err != nil {
+		return 0, err
+	}
+
+	var exported uint
+
+	err = h.verificationRepository.Stream(
+		ctx,
+		aggregate.VerificationFilter{
+			Status:            filter.Status,
+			Kind:              filter.Kind,
+			ExternalReference: filter.ExternalReference,
+			CreatedFrom:       filter.CreatedFrom,
+			CreatedTo:         filter.CreatedTo,
+			Sort:              sort,
+		},
+		func(verification *aggregate.Verification) error {
+			exported++
+
+			return writer.Write(verification)
+		},
+	)
+	if err != nil {
+		return exported, err
+	}
+
+	return exported, writer.Flush()
+}
@@ -0,0 +1,76 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/export"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleExportVerificationsQuerySuccess(t *testing.T) {
+	// assign
+	var out bytes.Buffer
+
+	first, err := aggregate.NewVerification(uuid.New().String(), aggregate.Identity, "Fancy verification description")
+	require.NoError(t, err)
+	second, err := aggregate.NewVerification(uuid.New().String(), aggregate.Identity, "Another verification description")
+	require.NoError(t, err)
+
+	sort, err := aggregate.NewVerificationSort(aggregate.SortByCreatedAt, false)
+	require.NoError(t, err)
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.
+		On("Stream", mock.Anything, aggregate.VerificationFilter{Kind: aggregate.Identity, Sort: sort}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(*aggregate.Verification) error)
+			require.NoError(t, fn(first))
+			require.NoError(t, fn(second))
+		}).
+		Return(nil)
+
+	exportVerificationsQuery := NewExportVerificationsQuery(ExportVerificationsFilter{
+		Kind:    aggregate.Identity,
+		Sort:    aggregate.SortByCreatedAt,
+		Format:  export.FormatCSV,
+		Columns: []string{export.ColumnUUID},
+	}, &out)
+
+	// act
+	exportVerificationsQueryHandler := NewExportVerificationsQueryHandler(verificationRepositoryMock)
+	exported, err := exportVerificationsQueryHandler.Handle(context.Background(), exportVerificationsQuery)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), exported)
+	assert.Equal(t, "uuid\n"+first.UUID().Value()+"\n"+second.UUID().Value()+"\n", out.String())
+}
+
+func TestExportVerificationsQueryValidationError(t *testing.T) {
+	// assign
+	exportVerificationsQuery := NewExportVerificationsQuery(ExportVerificationsFilter{
+		Status:  "unknown",
+		Sort:    "unknown",
+		Format:  "xml",
+		Columns: []string{"secret"},
+	}, &bytes.Buffer{})
+
+	// act
+	err := exportVerificationsQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Len(t, validationError.Errors, 4)
+}
@@ -2,8 +2,8 @@ package query
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 )
@@ -12,11 +12,17 @@ const GetVerificationByUUIDQueryType bus.QueryType = "get_by_uuid.verification.q
 
 // GetVerificationByUUIDQuery is the query dispatched to get verification by uuid.
 type GetVerificationByUUIDQuery struct {
+	bus.Returns[*aggregate.Verification]
 	uuid string
 }
 
-// NewGetVerificationByUUIDQuery creates a new GetVerificationByUUIDQuery.
+// NewGetVerificationByUUIDQuery creates a new GetVerificationByUUIDQuery. Valid uuid is stored in canonical
+// lowercase form, so the same verification requested in any letter case shares cached result.
 func NewGetVerificationByUUIDQuery(UUID string) GetVerificationByUUIDQuery {
+	if parsed, err := uuid.Parse(UUID); err == nil {
+		UUID = parsed.String()
+	}
+
 	return GetVerificationByUUIDQuery{
 		uuid: UUID,
 	}
@@ -27,6 +33,22 @@ func (q GetVerificationByUUIDQuery) Type() bus.QueryType {
 	return GetVerificationByUUIDQueryType
 }
 
+// AggregateID implements bus.AggregateAware interface.
+func (q GetVerificationByUUIDQuery) AggregateID() string {
+	return q.uuid
+}
+
+// Validate implements bus.Validatable interface.
+func (q GetVerificationByUUIDQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := aggregate.NewVerificationUUID(q.uuid); err != nil {
+		validationError.Add("uuid", err)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
 // GetVerificationByUUIDQueryHandler is the GetVerificationByUUIDQuery handler.
 type GetVerificationByUUIDQueryHandler struct {
 	verificationRepository aggregate.VerificationRepository
@@ -39,14 +61,12 @@ func NewGetVerificationByUUIDQueryHandler(verificationRepository aggregate.Verif
 	}
 }
 
-// Handle implements the bus.QueryHandler interface.
-func (h GetVerificationByUUIDQueryHandler) Handle(ctx context.Context, q bus.Query) (any, error) {
-	getVerificationCommand, ok := q.(GetVerificationByUUIDQuery)
-	if !ok {
-		return nil, fmt.Errorf("query type %s: %w", q.Type(), bus.ErrUnexpectedQuery)
-	}
-
-	verificationUUID, err := aggregate.NewVerificationUUID(getVerificationCommand.uuid)
+// Handle implements the bus.TypedQueryHandler interface.
+func (h GetVerificationByUUIDQueryHandler) Handle(
+	ctx context.Context,
+	getVerificationByUUIDQuery GetVerificationByUUIDQuery,
+) (*aggregate.Verification, error) {
+	verificationUUID, err := aggregate.NewVerificationUUID(getVerificationByUUIDQuery.uuid)
 	if err != nil {
 		return nil, err
 	}
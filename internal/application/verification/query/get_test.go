@@ -2,11 +2,13 @@ package query
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/test/mocks"
@@ -23,7 +25,10 @@ func TestHandleUnsupportedGetByUUIDQueryError(t *testing.T) {
 	verificationRepositoryMock := new(persistence.VerificationRepository)
 
 	// act
-	getVerificationByUUIDQueryHandler := NewGetVerificationByUUIDQueryHandler(verificationRepositoryMock)
+	getVerificationByUUIDQueryHandler, err := bus.NewQueryHandler[GetVerificationByUUIDQuery, *aggregate.Verification](
+		NewGetVerificationByUUIDQueryHandler(verificationRepositoryMock),
+	)
+	require.NoError(t, err)
 	verification, err := getVerificationByUUIDQueryHandler.Handle(context.Background(), unsupportedQuery)
 
 	// assert
@@ -52,5 +57,28 @@ func TestGetVerificationByUUIDQuerySuccess(t *testing.T) {
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
 	assert.NoError(t, err)
-	assert.Equal(t, expectedVerification.UUID(), verification.(*aggregate.Verification).UUID())
+	assert.Equal(t, expectedVerification.UUID(), verification.UUID())
+}
+
+func TestGetVerificationByUUIDQueryValidationError(t *testing.T) {
+	// assign
+	getVerificationByUUIDQuery := NewGetVerificationByUUIDQuery("invalidUUID")
+
+	// act
+	err := getVerificationByUUIDQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+}
+
+func TestGetVerificationByUUIDQueryCanonicalUUID(t *testing.T) {
+	// assign
+	verificationUUID := uuid.New()
+
+	// act
+	getVerificationByUUIDQuery := NewGetVerificationByUUIDQuery(strings.ToUpper(verificationUUID.String()))
+
+	// assert
+	assert.Equal(t, verificationUUID.String(), getVerificationByUUIDQuery.AggregateID())
+	assert.Equal(t, NewGetVerificationByUUIDQuery(verificationUUID.String()), getVerificationByUUIDQuery)
 }
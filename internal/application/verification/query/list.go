@@ -0,0 +1,147 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const (
+	ListVerificationsQueryType   bus.QueryType = "list.verification.query"
+	ListVerificationsDefaultSort               = "-" + aggregate.SortByCreatedAt
+	listVerificationsMaxLimit                  = 1000
+)
+
+// ListVerificationsFilter represents verifications listing parameters. Empty values are not filtered.
+// Sort is a field name optionally prefixed with "-" for descending order, Cursor is the NextCursor of previous page.
+type ListVerificationsFilter struct {
+	Status            string
+	Kind              string
+	ExternalReference string
+	CreatedFrom       time.Time
+	CreatedTo         time.Time
+	Sort              string
+	Cursor            string
+	Limit             uint
+}
+
+// VerificationsPage represents single page of listed verifications. NextCursor is empty on the last page.
+type VerificationsPage struct {
+	Verifications []*aggregate.Verification
+	NextCursor    string
+}
+
+// ListVerificationsQuery is the query dispatched to list verifications page by page.
+type ListVerificationsQuery struct {
+	bus.Returns[VerificationsPage]
+	filter ListVerificationsFilter
+}
+
+// NewListVerificationsQuery creates a new ListVerificationsQuery. Empty sort lists the newest verifications first.
+func NewListVerificationsQuery(filter ListVerificationsFilter) ListVerificationsQuery {
+	if filter.Sort == "" {
+		filter.Sort = ListVerificationsDefaultSort
+	}
+
+	return ListVerificationsQuery{
+		filter: filter,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q ListVerificationsQuery) Type() bus.QueryType {
+	return ListVerificationsQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q ListVerificationsQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	validateVerificationFilter(&validationError, q.filter.Status, q.filter.Kind, q.filter.CreatedFrom, q.filter.CreatedTo)
+
+	if _, err := parseSort(q.filter.Sort); err != nil {
+		validationError.Add("sort", err)
+	} else if q.filter.Cursor != "" {
+		if _, err := decodeCursor(q.filter.Cursor, q.filter.Sort); err != nil {
+			validationError.Add("cursor", err)
+		}
+	}
+
+	if q.filter.Limit == 0 || q.filter.Limit > listVerificationsMaxLimit {
+		validationError.Addf("limit", bus.ErrFieldOutOfRange, "must be between 1 and %d", listVerificationsMaxLimit)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// ListVerificationsQueryHandler is the ListVerificationsQuery handler.
+type ListVerificationsQueryHandler struct {
+	verificationRepository aggregate.VerificationRepository
+}
+
+// NewListVerificationsQueryHandler initializes a new ListVerificationsQueryHandler.
+func NewListVerificationsQueryHandler(verificationRepository aggregate.VerificationRepository) ListVerificationsQueryHandler {
+	return ListVerificationsQueryHandler{
+		verificationRepository: verificationRepository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+// One extra verification is fetched to tell whether the next page exists.
+func (h ListVerificationsQueryHandler) Handle(
+	ctx context.Context,
+	listVerificationsQuery ListVerificationsQuery,
+) (VerificationsPage, error) {
+	filter := listVerificationsQuery.filter
+
+	sort, err := parseSort(filter.Sort)
+	if err != nil {
+		return VerificationsPage{}, err
+	}
+
+	var after *aggregate.VerificationCursor
+
+	if filter.Cursor != "" {
+		if after, err = decodeCursor(filter.Cursor, filter.Sort); err != nil {
+			return VerificationsPage{}, err
+		}
+	}
+
+	verifications, err := h.verificationRepository.List(ctx, aggregate.VerificationFilter{
+		Status:            filter.Status,
+		Kind:              filter.Kind,
+		ExternalReference: filter.ExternalReference,
+		CreatedFrom:       filter.CreatedFrom,
+		CreatedTo:         filter.CreatedTo,
+		Sort:              sort,
+		After:             after,
+		Limit:             filter.Limit + 1,
+	})
+	if err != nil {
+		return VerificationsPage{}, err
+	}
+
+	page := VerificationsPage{Verifications: verifications}
+
+	if uint(len(verifications)) > filter.Limit {
+		page.Verifications = verifications[:filter.Limit]
+		page.NextCursor = encodeCursor(page.Verifications[filter.Limit-1], filter.Sort, sort)
+	}
+
+	return page, nil
+}
+
+// validateVerificationFilter validates optional status, kind and creation date range filters.
+func validateVerificationFilter(
+	validationError *bus.ValidationError,
+	status, kind string,
+	createdFrom, createdTo time.Time,
+) {
+	validateViewFilter(validationError, status, kind)
+
+	if !createdFrom.IsZero() && !createdTo.IsZero() && !createdFrom.Before(createdTo) {
+		validationError.Addf("createdTo", bus.ErrFieldOutOfRange, "must be after createdFrom")
+	}
+}
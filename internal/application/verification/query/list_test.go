@@ -0,0 +1,109 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func newListedVerification(t *testing.T, id uint32, createdAt time.Time) *aggregate.Verification {
+	verification, err := aggregate.NewVerification(
+		uuid.New().String(),
+		aggregate.Identity,
+		"Fancy verification document description",
+	)
+	require.NoError(t, err)
+
+	verification.WithID(id)
+	verification.WithCreatedAt(createdAt)
+
+	return verification
+}
+
+func TestHandleListVerificationsQueryNextPage(t *testing.T) {
+	// assign
+	now := time.Now().UTC()
+	first := newListedVerification(t, 3, now)
+	second := newListedVerification(t, 2, now.Add(-time.Minute))
+	third := newListedVerification(t, 1, now.Add(-2*time.Minute))
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.On("List", mock.Anything, mock.MatchedBy(func(filter aggregate.VerificationFilter) bool {
+		return filter.After == nil && filter.Limit == 3 && filter.Sort.Descending()
+	})).Return([]*aggregate.Verification{first, second, third}, nil)
+	verificationRepositoryMock.On("List", mock.Anything, mock.MatchedBy(func(filter aggregate.VerificationFilter) bool {
+		return filter.After != nil && filter.After.ID == 2 && filter.After.CreatedAt.Equal(second.CreatedAt())
+	})).Return([]*aggregate.Verification{third}, nil)
+
+	listVerificationsQueryHandler := NewListVerificationsQueryHandler(verificationRepositoryMock)
+
+	// act
+	firstPage, err := listVerificationsQueryHandler.Handle(
+		context.Background(),
+		NewListVerificationsQuery(ListVerificationsFilter{Limit: 2}),
+	)
+	require.NoError(t, err)
+	lastPage, err := listVerificationsQueryHandler.Handle(
+		context.Background(),
+		NewListVerificationsQuery(ListVerificationsFilter{Limit: 2, Cursor: firstPage.NextCursor}),
+	)
+	require.NoError(t, err)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	assert.Equal(t, []*aggregate.Verification{first, second}, firstPage.Verifications)
+	assert.NotEmpty(t, firstPage.NextCursor)
+	assert.Equal(t, []*aggregate.Verification{third}, lastPage.Verifications)
+	assert.Empty(t, lastPage.NextCursor)
+}
+
+func TestListVerificationsQueryValidationError(t *testing.T) {
+	// assign
+	listVerificationsQuery := NewListVerificationsQuery(ListVerificationsFilter{
+		Status:      "unknown",
+		CreatedFrom: time.Now(),
+		CreatedTo:   time.Now().Add(-time.Hour),
+		Sort:        "description",
+		Limit:       0,
+	})
+
+	// act
+	err := listVerificationsQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Len(t, validationError.Errors, 4)
+}
+
+func TestListVerificationsQueryCursorOfAnotherSortError(t *testing.T) {
+	// assign
+	verification := newListedVerification(t, 1, time.Now())
+	sort, err := parseSort("status")
+	require.NoError(t, err)
+
+	listVerificationsQuery := NewListVerificationsQuery(ListVerificationsFilter{
+		Sort:   "-createdAt",
+		Cursor: encodeCursor(verification, "status", sort),
+		Limit:  10,
+	})
+
+	// act
+	err = listVerificationsQuery.Validate()
+
+	// assert
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	require.Len(t, validationError.Errors, 1)
+	assert.Equal(t, ErrCursorSortChange.Error(), validationError.Errors[0].Message)
+}
@@ -0,0 +1,91 @@
+package query
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const (
+	ListVerificationViewsQueryType bus.QueryType = "list_views.verification.query"
+	listVerificationViewsMaxLimit                = 1000
+)
+
+// ListVerificationViewsQuery is the query dispatched to list verifications from the read model.
+type ListVerificationViewsQuery struct {
+	bus.Returns[[]*readmodel.VerificationView]
+	status string
+	kind   string
+	limit  uint
+	offset uint
+}
+
+// NewListVerificationViewsQuery creates a new ListVerificationViewsQuery. Empty status and kind are not filtered.
+func NewListVerificationViewsQuery(status, kind string, limit, offset uint) ListVerificationViewsQuery {
+	return ListVerificationViewsQuery{
+		status: status,
+		kind:   kind,
+		limit:  limit,
+		offset: offset,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q ListVerificationViewsQuery) Type() bus.QueryType {
+	return ListVerificationViewsQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q ListVerificationViewsQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	validateViewFilter(&validationError, q.status, q.kind)
+
+	if q.limit == 0 || q.limit > listVerificationViewsMaxLimit {
+		validationError.Addf("limit", bus.ErrFieldOutOfRange, "must be between 1 and %d", listVerificationViewsMaxLimit)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// ListVerificationViewsQueryHandler is the ListVerificationViewsQuery handler.
+type ListVerificationViewsQueryHandler struct {
+	verificationViewRepository readmodel.Repository
+}
+
+// NewListVerificationViewsQueryHandler initializes a new ListVerificationViewsQueryHandler.
+func NewListVerificationViewsQueryHandler(verificationViewRepository readmodel.Repository) ListVerificationViewsQueryHandler {
+	return ListVerificationViewsQueryHandler{
+		verificationViewRepository: verificationViewRepository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h ListVerificationViewsQueryHandler) Handle(
+	ctx context.Context,
+	listVerificationViewsQuery ListVerificationViewsQuery,
+) ([]*readmodel.VerificationView, error) {
+	return h.verificationViewRepository.List(ctx, readmodel.Filter{
+		Status: listVerificationViewsQuery.status,
+		Kind:   listVerificationViewsQuery.kind,
+		Limit:  listVerificationViewsQuery.limit,
+		Offset: listVerificationViewsQuery.offset,
+	})
+}
+
+// validateViewFilter validates optional read model status and kind filters.
+func validateViewFilter(validationError *bus.ValidationError, status, kind string) {
+	if status != "" {
+		if _, err := aggregate.NewVerificationStatus(status); err != nil {
+			validationError.Add("status", err)
+		}
+	}
+
+	if kind != "" {
+		if _, err := aggregate.NewVerificationKind(kind); err != nil {
+			validationError.Add("kind", err)
+		}
+	}
+}
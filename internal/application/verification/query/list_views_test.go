@@ -0,0 +1,75 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleListVerificationViewsQuerySuccess(t *testing.T) {
+	// assign
+	views := []*readmodel.VerificationView{{UUID: "1", Status: aggregate.Draft}}
+
+	verificationViewRepositoryMock := new(persistence.VerificationViewRepository)
+	verificationViewRepositoryMock.On("List", mock.Anything, readmodel.Filter{
+		Status: aggregate.Draft,
+		Kind:   aggregate.Identity,
+		Limit:  10,
+		Offset: 20,
+	}).Return(views, nil)
+
+	// act
+	listVerificationViewsQueryHandler := NewListVerificationViewsQueryHandler(verificationViewRepositoryMock)
+	result, err := listVerificationViewsQueryHandler.Handle(
+		context.Background(),
+		NewListVerificationViewsQuery(aggregate.Draft, aggregate.Identity, 10, 20),
+	)
+
+	// assert
+	verificationViewRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, views, result)
+}
+
+func TestListVerificationViewsQueryValidationError(t *testing.T) {
+	// assign
+	listVerificationViewsQuery := NewListVerificationViewsQuery("unknown", "unknown", 0, 0)
+
+	// act
+	err := listVerificationViewsQuery.Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+	var validationError *bus.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Len(t, validationError.Errors, 3)
+}
+
+func TestHandleCountVerificationViewsQuerySuccess(t *testing.T) {
+	// assign
+	counts := map[string]uint{aggregate.Draft: 2, aggregate.Approved: 1}
+
+	verificationViewRepositoryMock := new(persistence.VerificationViewRepository)
+	verificationViewRepositoryMock.On("CountByStatus", mock.Anything, readmodel.Filter{Kind: aggregate.Document}).
+		Return(counts, nil)
+
+	// act
+	countVerificationViewsQueryHandler := NewCountVerificationViewsQueryHandler(verificationViewRepositoryMock)
+	result, err := countVerificationViewsQueryHandler.Handle(
+		context.Background(),
+		NewCountVerificationViewsQuery(aggregate.Document),
+	)
+
+	// assert
+	verificationViewRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, counts, result)
+}
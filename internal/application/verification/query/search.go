@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+)
+
+const (
+	SearchVerificationsQueryType     bus.QueryType = "search.verification.query"
+	searchVerificationsMaxLimit                    = 100
+	searchVerificationsMaxTextLength               = 200
+)
+
+// SearchVerificationsQuery is the query dispatched to full-text search verifications by description and decline reason.
+type SearchVerificationsQuery struct {
+	bus.Returns[[]*readmodel.SearchResult]
+	text  string
+	limit uint
+}
+
+// NewSearchVerificationsQuery creates a new SearchVerificationsQuery.
+func NewSearchVerificationsQuery(text string, limit uint) SearchVerificationsQuery {
+	return SearchVerificationsQuery{
+		text:  strings.TrimSpace(text),
+		limit: limit,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q SearchVerificationsQuery) Type() bus.QueryType {
+	return SearchVerificationsQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q SearchVerificationsQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if q.text == "" {
+		validationError.Add("q", bus.ErrFieldRequired)
+	} else if utf8.RuneCountInString(q.text) > searchVerificationsMaxTextLength {
+		validationError.Addf("q", bus.ErrFieldTooLong, "must be at most %d characters", searchVerificationsMaxTextLength)
+	}
+
+	if q.limit == 0 || q.limit > searchVerificationsMaxLimit {
+		validationError.Addf("limit", bus.ErrFieldOutOfRange, "must be between 1 and %d", searchVerificationsMaxLimit)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// SearchVerificationsQueryHandler is the SearchVerificationsQuery handler.
+type SearchVerificationsQueryHandler struct {
+	verificationViewRepository readmodel.Repository
+}
+
+// NewSearchVerificationsQueryHandler initializes a new SearchVerificationsQueryHandler.
+func NewSearchVerificationsQueryHandler(verificationViewRepository readmodel.Repository) SearchVerificationsQueryHandler {
+	return SearchVerificationsQueryHandler{
+		verificationViewRepository: verificationViewRepository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h SearchVerificationsQueryHandler) Handle(
+	ctx context.Context,
+	searchVerificationsQuery SearchVerificationsQuery,
+) ([]*readmodel.SearchResult, error) {
+	return h.verificationViewRepository.Search(ctx, searchVerificationsQuery.text, searchVerificationsQuery.limit)
+}
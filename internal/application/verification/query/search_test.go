@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleSearchVerificationsQuerySuccess(t *testing.T) {
+	// assign
+	results := []*readmodel.SearchResult{{
+		View:    &readmodel.VerificationView{UUID: "1", Description: "passport photo"},
+		Rank:    0.5,
+		Snippet: "<mark>passport</mark> photo",
+	}}
+
+	verificationViewRepositoryMock := new(persistence.VerificationViewRepository)
+	verificationViewRepositoryMock.On("Search", mock.Anything, "passport", uint(10)).Return(results, nil)
+
+	// act
+	searchVerificationsQueryHandler := NewSearchVerificationsQueryHandler(verificationViewRepositoryMock)
+	result, err := searchVerificationsQueryHandler.Handle(
+		context.Background(),
+		NewSearchVerificationsQuery("  passport ", 10),
+	)
+
+	// assert
+	verificationViewRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, results, result)
+}
+
+func TestSearchVerificationsQueryValidationError(t *testing.T) {
+	tests := []struct {
+		name  string
+		query SearchVerificationsQuery
+	}{
+		{name: "blank text", query: NewSearchVerificationsQuery("   ", 10)},
+		{name: "too long text", query: NewSearchVerificationsQuery(strings.Repeat("a", searchVerificationsMaxTextLength+1), 10)},
+		{name: "zero limit", query: NewSearchVerificationsQuery("passport", 0)},
+		{name: "too big limit", query: NewSearchVerificationsQuery("passport", searchVerificationsMaxLimit+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// act
+			err := tt.query.Validate()
+
+			// assert
+			assert.ErrorIs(t, err, bus.ErrValidationFailed)
+
+			var validationError *bus.ValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Len(t, validationError.Errors, 1)
+		})
+	}
+}
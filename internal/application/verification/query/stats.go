@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/stats"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const (
+	VerificationStatsQueryType bus.QueryType = "stats.verification.query"
+	VerificationStatsMaxPeriod               = 366 * 24 * time.Hour
+)
+
+// VerificationStats represents verification throughput over Period.
+// Rates are shares of approved and declined verifications among decided ones.
+type VerificationStats struct {
+	Period       stats.Period
+	Total        uint
+	ByStatus     map[string]uint
+	ByKind       map[string]uint
+	ApprovalRate float64
+	DeclineRate  float64
+	DecisionTime stats.DecisionTime
+	Daily        []stats.DailyBucket
+}
+
+// VerificationStatsQuery is the query dispatched to collect verification statistics of verifications created within period.
+type VerificationStatsQuery struct {
+	bus.Returns[VerificationStats]
+	period stats.Period
+}
+
+// NewVerificationStatsQuery creates a new VerificationStatsQuery for [from, to) period.
+func NewVerificationStatsQuery(from, to time.Time) VerificationStatsQuery {
+	return VerificationStatsQuery{
+		period: stats.Period{From: from, To: to},
+	}
+}
+
+// Type implements bus.Query interface.
+func (q VerificationStatsQuery) Type() bus.QueryType {
+	return VerificationStatsQueryType
+}
+
+// Validate implements bus.Validatable interface.
+func (q VerificationStatsQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if q.period.From.IsZero() {
+		validationError.Add("from", bus.ErrFieldRequired)
+	}
+
+	if q.period.To.IsZero() {
+		validationError.Add("to", bus.ErrFieldRequired)
+	}
+
+	if validationError.ErrorOrNil() != nil {
+		return validationError.ErrorOrNil()
+	}
+
+	if !q.period.From.Before(q.period.To) {
+		validationError.Addf("to", bus.ErrFieldOutOfRange, "must be after from")
+	} else if q.period.To.Sub(q.period.From) > VerificationStatsMaxPeriod {
+		validationError.Addf("to", bus.ErrFieldOutOfRange, "period must not be longer than %d days", VerificationStatsMaxPeriod/(24*time.Hour))
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// VerificationStatsQueryHandler is the VerificationStatsQuery handler.
+type VerificationStatsQueryHandler struct {
+	statsRepository stats.Repository
+}
+
+// NewVerificationStatsQueryHandler initializes a new VerificationStatsQueryHandler.
+func NewVerificationStatsQueryHandler(statsRepository stats.Repository) VerificationStatsQueryHandler {
+	return VerificationStatsQueryHandler{
+		statsRepository: statsRepository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface.
+func (h VerificationStatsQueryHandler) Handle(
+	ctx context.Context,
+	verificationStatsQuery VerificationStatsQuery,
+) (VerificationStats, error) {
+	period := verificationStatsQuery.period
+
+	counts, err := h.statsRepository.Counts(ctx, period)
+	if err != nil {
+		return VerificationStats{}, err
+	}
+
+	decisionTime, err := h.statsRepository.DecisionTime(ctx, period)
+	if err != nil {
+		return VerificationStats{}, err
+	}
+
+	daily, err := h.statsRepository.DailyBuckets(ctx, period)
+	if err != nil {
+		return VerificationStats{}, err
+	}
+
+	result := VerificationStats{
+		Period:       period,
+		ByStatus:     make(map[string]uint),
+		ByKind:       make(map[string]uint),
+		DecisionTime: decisionTime,
+		Daily:        daily,
+	}
+
+	for _, count := range counts {
+		result.Total += count.Total
+		result.ByStatus[count.Status] += count.Total
+		result.ByKind[count.Kind] += count.Total
+	}
+
+	approved, declined := result.ByStatus[aggregate.Approved], result.ByStatus[aggregate.Declined]
+	if decided := approved + declined; decided > 0 {
+		result.ApprovalRate = float64(approved) / float64(decided)
+		result.DeclineRate = float64(declined) / float64(decided)
+	}
+
+	return result, nil
+}
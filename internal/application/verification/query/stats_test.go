@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/stats"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleVerificationStatsQuerySuccess(t *testing.T) {
+	// assign
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	period := stats.Period{From: from, To: to}
+	decisionTime := stats.DecisionTime{Decided: 4, P50: time.Hour, P90: 3 * time.Hour}
+	daily := []stats.DailyBucket{{Day: from, Created: 5, Approved: 3, Declined: 1}}
+
+	statsRepositoryMock := new(persistence.VerificationStatsRepository)
+	statsRepositoryMock.On("Counts", mock.Anything, period).Return([]stats.Count{
+		{Status: aggregate.Approved, Kind: aggregate.Identity, Total: 2},
+		{Status: aggregate.Approved, Kind: aggregate.Document, Total: 1},
+		{Status: aggregate.Declined, Kind: aggregate.Identity, Total: 1},
+		{Status: aggregate.Draft, Kind: aggregate.Document, Total: 1},
+	}, nil)
+	statsRepositoryMock.On("DecisionTime", mock.Anything, period).Return(decisionTime, nil)
+	statsRepositoryMock.On("DailyBuckets", mock.Anything, period).Return(daily, nil)
+
+	// act
+	verificationStatsQueryHandler := NewVerificationStatsQueryHandler(statsRepositoryMock)
+	result, err := verificationStatsQueryHandler.Handle(context.Background(), NewVerificationStatsQuery(from, to))
+
+	// assert
+	statsRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, uint(5), result.Total)
+	assert.Equal(t, map[string]uint{aggregate.Approved: 3, aggregate.Declined: 1, aggregate.Draft: 1}, result.ByStatus)
+	assert.Equal(t, map[string]uint{aggregate.Identity: 3, aggregate.Document: 2}, result.ByKind)
+	assert.Equal(t, 0.75, result.ApprovalRate)
+	assert.Equal(t, 0.25, result.DeclineRate)
+	assert.Equal(t, decisionTime, result.DecisionTime)
+	assert.Equal(t, daily, result.Daily)
+}
+
+func TestHandleVerificationStatsQueryRepositoryError(t *testing.T) {
+	// assign
+	errStats := errors.New("stats failed")
+	from := time.Now().AddDate(0, 0, -1)
+
+	statsRepositoryMock := new(persistence.VerificationStatsRepository)
+	statsRepositoryMock.On("Counts", mock.Anything, mock.Anything).Return(nil, errStats)
+
+	// act
+	verificationStatsQueryHandler := NewVerificationStatsQueryHandler(statsRepositoryMock)
+	_, err := verificationStatsQueryHandler.Handle(context.Background(), NewVerificationStatsQuery(from, time.Now()))
+
+	// assert
+	assert.ErrorIs(t, err, errStats)
+}
+
+func TestVerificationStatsQueryValidationError(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		query VerificationStatsQuery
+	}{
+		{name: "empty period", query: NewVerificationStatsQuery(time.Time{}, time.Time{})},
+		{name: "reversed period", query: NewVerificationStatsQuery(now, now.Add(-time.Hour))},
+		{name: "too long period", query: NewVerificationStatsQuery(now.Add(-VerificationStatsMaxPeriod-time.Hour), now)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// act
+			err := tt.query.Validate()
+
+			// assert
+			assert.ErrorIs(t, err, bus.ErrValidationFailed)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const (
+	GetVerificationTimelineQueryType bus.QueryType = "timeline.verification.query"
+
+	TimelineCreated   = "created"
+	TimelineApproved  = "approved"
+	TimelineDeclined  = "declined"
+	TimelineCancelled = "cancelled"
+)
+
+// timelineEntryTypes maps recorded verification status to timeline entry type.
+var timelineEntryTypes = map[string]string{
+	aggregate.Draft:     TimelineCreated,
+	aggregate.Approved:  TimelineApproved,
+	aggregate.Declined:  TimelineDeclined,
+	aggregate.Cancelled: TimelineCancelled,
+}
+
+// TimelineEntry represents single typed event of verification timeline.
+// Automated entries are made by system processes, e.g. expiry, rather than by a person.
+// ActorVerified is false when Actor was supplied by the client and is not authenticated.
+type TimelineEntry struct {
+	Type          string
+	Status        string
+	Reason        string
+	Actor         string
+	ActorVerified bool
+	Automated     bool
+	OccurredAt    time.Time
+}
+
+// GetVerificationTimelineQuery is the query dispatched to get chronological history of verification.
+type GetVerificationTimelineQuery struct {
+	bus.Returns[[]TimelineEntry]
+	uuid string
+}
+
+// NewGetVerificationTimelineQuery creates a new GetVerificationTimelineQuery.
+func NewGetVerificationTimelineQuery(UUID string) GetVerificationTimelineQuery {
+	return GetVerificationTimelineQuery{
+		uuid: UUID,
+	}
+}
+
+// Type implements bus.Query interface.
+func (q GetVerificationTimelineQuery) Type() bus.QueryType {
+	return GetVerificationTimelineQueryType
+}
+
+// AggregateID implements bus.AggregateAware interface.
+func (q GetVerificationTimelineQuery) AggregateID() string {
+	return q.uuid
+}
+
+// Validate implements bus.Validatable interface.
+func (q GetVerificationTimelineQuery) Validate() error {
+	var validationError bus.ValidationError
+
+	if _, err := aggregate.NewVerificationUUID(q.uuid); err != nil {
+		validationError.Add("uuid", err)
+	}
+
+	return validationError.ErrorOrNil()
+}
+
+// GetVerificationTimelineQueryHandler is the GetVerificationTimelineQuery handler.
+type GetVerificationTimelineQueryHandler struct {
+	verificationRepository aggregate.VerificationRepository
+	historyRepository      aggregate.HistoryRepository
+}
+
+// NewGetVerificationTimelineQueryHandler initializes a new GetVerificationTimelineQueryHandler.
+func NewGetVerificationTimelineQueryHandler(
+	verificationRepository aggregate.VerificationRepository,
+	historyRepository aggregate.HistoryRepository,
+) GetVerificationTimelineQueryHandler {
+	return GetVerificationTimelineQueryHandler{
+		verificationRepository: verificationRepository,
+		historyRepository:      historyRepository,
+	}
+}
+
+// Handle implements the bus.TypedQueryHandler interface. Unknown verification fails with not found error
+// instead of returning an empty timeline.
+func (h GetVerificationTimelineQueryHandler) Handle(
+	ctx context.Context,
+	getVerificationTimelineQuery GetVerificationTimelineQuery,
+) ([]TimelineEntry, error) {
+	verificationUUID, err := aggregate.NewVerificationUUID(getVerificationTimelineQuery.uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.verificationRepository.GetByUUID(ctx, verificationUUID); err != nil {
+		return nil, err
+	}
+
+	history, err := h.historyRepository.ListByVerification(ctx, verificationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := make([]TimelineEntry, len(history))
+
+	for i, entry := range history {
+		automated := aggregate.IsSystemActor(entry.Actor)
+
+		// system actors are set only by the service itself: they are stripped from request header and rejected
+		// in scheduled command payloads, other actors are given by unauthenticated clients
+		timeline[i] = TimelineEntry{
+			Type:          timelineEntryTypes[entry.Status],
+			Status:        entry.Status,
+			Reason:        entry.Reason,
+			Actor:         entry.Actor,
+			ActorVerified: automated,
+			Automated:     automated,
+			OccurredAt:    entry.OccurredAt,
+		}
+	}
+
+	return timeline, nil
+}
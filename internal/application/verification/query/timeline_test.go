@@ -0,0 +1,82 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestHandleGetVerificationTimelineQuerySuccess(t *testing.T) {
+	// assign
+	verification, err := aggregate.NewVerification(uuid.New().String(), aggregate.Identity, "Fancy verification description")
+	require.NoError(t, err)
+
+	createdAt := time.Now().Add(-time.Hour)
+	cancelledAt := time.Now()
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, verification.UUID()).Return(verification, nil)
+
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	historyRepositoryMock.On("ListByVerification", mock.Anything, verification.UUID()).Return([]*aggregate.HistoryEntry{
+		{Status: aggregate.Draft, Actor: "agent@example.com", OccurredAt: createdAt},
+		{Status: aggregate.Cancelled, Actor: "system:expiry", OccurredAt: cancelledAt},
+	}, nil)
+
+	// act
+	getVerificationTimelineQueryHandler := NewGetVerificationTimelineQueryHandler(verificationRepositoryMock, historyRepositoryMock)
+	timeline, err := getVerificationTimelineQueryHandler.Handle(
+		context.Background(),
+		NewGetVerificationTimelineQuery(verification.UUID().Value()),
+	)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
+	require.NoError(t, err)
+	assert.Equal(t, []TimelineEntry{
+		{Type: TimelineCreated, Status: aggregate.Draft, Actor: "agent@example.com", OccurredAt: createdAt},
+		{
+			Type:          TimelineCancelled,
+			Status:        aggregate.Cancelled,
+			Actor:         "system:expiry",
+			ActorVerified: true,
+			Automated:     true,
+			OccurredAt:    cancelledAt,
+		},
+	}, timeline)
+}
+
+func TestHandleGetVerificationTimelineQueryNotFoundError(t *testing.T) {
+	// assign
+	verificationUUID := uuid.New().String()
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(nil, postgres.ErrVerificationNotFound)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+
+	// act
+	getVerificationTimelineQueryHandler := NewGetVerificationTimelineQueryHandler(verificationRepositoryMock, historyRepositoryMock)
+	_, err := getVerificationTimelineQueryHandler.Handle(context.Background(), NewGetVerificationTimelineQuery(verificationUUID))
+
+	// assert
+	historyRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, postgres.ErrVerificationNotFound)
+}
+
+func TestGetVerificationTimelineQueryValidationError(t *testing.T) {
+	// act
+	err := NewGetVerificationTimelineQuery("invalidUUID").Validate()
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+}
@@ -0,0 +1,63 @@
+package readmodel
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+// VerificationView represents denormalized verification read model used for listings and dashboards.
+type VerificationView struct {
+	UUID          string
+	Kind          string
+	Description   string
+	Status        string
+	DeclineReason string
+	CreatedAt     time.Time
+	ProjectedAt   time.Time
+}
+
+// NewVerificationView creates VerificationView from the current aggregate.Verification state.
+func NewVerificationView(verification *aggregate.Verification, projectedAt time.Time) *VerificationView {
+	return &VerificationView{
+		UUID:          verification.UUID().Value(),
+		Kind:          verification.Kind().Value(),
+		Description:   verification.Description().Value(),
+		Status:        verification.Status().Value(),
+		DeclineReason: verification.DeclineReason().Value(),
+		CreatedAt:     verification.CreatedAt(),
+		ProjectedAt:   projectedAt,
+	}
+}
+
+// Filter represents verification views listing criteria. Zero values are ignored.
+type Filter struct {
+	Status string
+	Kind   string
+	Limit  uint
+	Offset uint
+}
+
+// SearchResult represents VerificationView matching full-text search with its relevance Rank
+// and Snippet of description and decline reason with matched words highlighted.
+// Snippet text is HTML-escaped and matched words are wrapped in <mark> tags.
+type SearchResult struct {
+	View    *VerificationView
+	Rank    float64
+	Snippet string
+}
+
+// Repository defines VerificationView storage.
+type Repository interface {
+	// Save inserts or replaces view of verification.
+	Save(ctx context.Context, view *VerificationView) error
+	// List returns views matching filter, the newest first.
+	List(ctx context.Context, filter Filter) ([]*VerificationView, error)
+	// CountByStatus returns the number of views matching filter per status. Status, limit and offset are ignored.
+	CountByStatus(ctx context.Context, filter Filter) (map[string]uint, error)
+	// Search returns at most limit views whose description or decline reason match text, the most relevant first.
+	Search(ctx context.Context, text string, limit uint) ([]*SearchResult, error)
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=Repository --structname=VerificationViewRepository --filename=verification_view_repository.go
@@ -0,0 +1,72 @@
+package saga
+
+import (
+	"errors"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/saga"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+const (
+	ExpirySagaType       saga.Type = "expiry.verification.saga"
+	AwaitingDecisionStep           = "awaiting_decision"
+	ExpiringStep                   = "expiring"
+	// ExpiryActor is recorded as initiator of verification cancelled by ExpirySaga.
+	ExpiryActor = aggregate.SystemActorPrefix + "expiry"
+)
+
+// ExpirySaga cancels verification which is neither approved nor declined in time.
+type ExpirySaga struct {
+	expireAfter time.Duration
+}
+
+// NewExpirySaga creates a new ExpirySaga.
+func NewExpirySaga(expireAfter time.Duration) ExpirySaga {
+	return ExpirySaga{
+		expireAfter: expireAfter,
+	}
+}
+
+// Type implements saga.Saga interface.
+func (s ExpirySaga) Type() saga.Type {
+	return ExpirySagaType
+}
+
+// Starts implements saga.Saga interface. Saga is started by verification creation.
+func (s ExpirySaga) Starts(cmd bus.Command) bool {
+	_, ok := cmd.(command.CreateVerificationCommand)
+
+	return ok
+}
+
+// Handle implements saga.Saga interface.
+func (s ExpirySaga) Handle(state *saga.State, cmd bus.Command, err error) []bus.Command {
+	switch cmd.(type) {
+	case command.CreateVerificationCommand:
+		state.Transition(AwaitingDecisionStep)
+		state.ScheduleTimeout(time.Now().Add(s.expireAfter))
+	case command.ApproveVerificationCommand, command.DeclineVerificationCommand:
+		if err == nil {
+			state.Complete()
+		}
+	case command.CancelVerificationCommand:
+		switch {
+		case err == nil, errors.Is(err, aggregate.ErrAlreadyProcessed):
+			state.Complete()
+		case state.Step == ExpiringStep:
+			state.Fail(err)
+		}
+	}
+
+	return nil
+}
+
+// Timeout implements saga.Saga interface. Expired verification is cancelled.
+func (s ExpirySaga) Timeout(state *saga.State) []bus.Command {
+	state.Transition(ExpiringStep)
+
+	return []bus.Command{command.NewCancelVerificationCommand(state.AggregateID).WithActor(ExpiryActor)}
+}
@@ -0,0 +1,89 @@
+package saga
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/saga"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+var errTestCancelFailed = errors.New("test cancel failed")
+
+func TestExpirySagaWaitsForDecision(t *testing.T) {
+	// assign
+	verificationUUID := uuid.New()
+	expirySaga := NewExpirySaga(time.Hour)
+	state := saga.NewState(ExpirySagaType, verificationUUID.String())
+	createCommand := command.NewCreateVerificationCommand(verificationUUID, "Fancy verification description", aggregate.Identity)
+
+	// act
+	starts := expirySaga.Starts(createCommand)
+	commands := expirySaga.Handle(state, createCommand, nil)
+
+	// assert
+	assert.True(t, starts)
+	assert.Empty(t, commands)
+	assert.Equal(t, AwaitingDecisionStep, state.Step)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *state.TimeoutAt, time.Minute)
+}
+
+func TestExpirySagaCompletesOnDecision(t *testing.T) {
+	// assign
+	expirySaga := NewExpirySaga(time.Hour)
+	state := saga.NewState(ExpirySagaType, uuid.New().String())
+	state.Transition(AwaitingDecisionStep)
+
+	// act
+	expirySaga.Handle(state, command.NewApproveVerificationCommand(state.AggregateID), nil)
+
+	// assert
+	assert.Equal(t, saga.Completed, state.Status)
+}
+
+func TestExpirySagaCancelsOnTimeout(t *testing.T) {
+	// assign
+	expirySaga := NewExpirySaga(time.Hour)
+	state := saga.NewState(ExpirySagaType, uuid.New().String())
+	state.Transition(AwaitingDecisionStep)
+
+	// act
+	commands := expirySaga.Timeout(state)
+
+	// assert
+	assert.Equal(t, ExpiringStep, state.Step)
+	assert.Equal(t, []bus.Command{command.NewCancelVerificationCommand(state.AggregateID).WithActor(ExpiryActor)}, commands)
+}
+
+func TestExpirySagaCancelOutcome(t *testing.T) {
+	testCases := map[string]struct {
+		err            error
+		expectedStatus saga.Status
+	}{
+		"cancelled":         {err: nil, expectedStatus: saga.Completed},
+		"already processed": {err: aggregate.ErrAlreadyProcessed, expectedStatus: saga.Completed},
+		"failed":            {err: errTestCancelFailed, expectedStatus: saga.Failed},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			// assign
+			expirySaga := NewExpirySaga(time.Hour)
+			state := saga.NewState(ExpirySagaType, uuid.New().String())
+			state.Transition(ExpiringStep)
+
+			// act
+			expirySaga.Handle(state, command.NewCancelVerificationCommand(state.AggregateID), testCase.err)
+
+			// assert
+			assert.Equal(t, testCase.expectedStatus, state.Status)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package stats
+
+import (
+	"context"
+	"time"
+)
+
+// Period represents half-open [From, To) date range statistics are collected for.
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// Count represents the number of verifications with Status and Kind.
+type Count struct {
+	Status string
+	Kind   string
+	Total  uint
+}
+
+// DecisionTime represents time-to-decision percentiles of approved and declined verifications.
+// Decided is the number of verifications percentiles are computed from, percentiles are zero when it is zero.
+type DecisionTime struct {
+	Decided uint
+	P50     time.Duration
+	P90     time.Duration
+}
+
+// DailyBucket represents verifications created and decided during single UTC Day.
+type DailyBucket struct {
+	Day      time.Time
+	Created  uint
+	Approved uint
+	Declined uint
+}
+
+// Repository defines verification statistics storage.
+// Counts and DecisionTime cover verifications created within period, DailyBuckets group creations and decisions by day.
+type Repository interface {
+	Counts(ctx context.Context, period Period) ([]Count, error)
+	DecisionTime(ctx context.Context, period Period) (DecisionTime, error)
+	DailyBuckets(ctx context.Context, period Period) ([]DailyBucket, error)
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=Repository --structname=VerificationStatsRepository --filename=verification_stats_repository.go
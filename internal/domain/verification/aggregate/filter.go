@@ -0,0 +1,76 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidVerificationSort = errors.New("invalid verification sort field")
+
+const (
+	SortByCreatedAt string = "createdAt"
+	SortByStatus    string = "status"
+	SortByKind      string = "kind"
+)
+
+// VerificationSort represents the verifications listing order. Verifications with equal sort field are ordered by ID.
+type VerificationSort struct {
+	field      string
+	descending bool
+}
+
+// NewVerificationSort instantiate the VO for VerificationSort.
+func NewVerificationSort(field string, descending bool) (VerificationSort, error) {
+	if field != SortByCreatedAt && field != SortByStatus && field != SortByKind {
+		return VerificationSort{}, ErrInvalidVerificationSort
+	}
+
+	return VerificationSort{field: field, descending: descending}, nil
+}
+
+// Field returns the VerificationSort field.
+func (s VerificationSort) Field() string {
+	return s.field
+}
+
+// Descending reports whether verifications are listed in descending order.
+func (s VerificationSort) Descending() bool {
+	return s.descending
+}
+
+// VerificationCursor points to the last verification of the previous page by its sort field value and ID,
+// so the next page starts right after it even if verifications are added meanwhile.
+type VerificationCursor struct {
+	CreatedAt time.Time
+	Value     string
+	ID        uint32
+}
+
+// NewVerificationCursor creates VerificationCursor pointing to verification in specific sort order.
+func NewVerificationCursor(verification *Verification, sort VerificationSort) VerificationCursor {
+	cursor := VerificationCursor{ID: verification.ID().Value()}
+
+	switch sort.field {
+	case SortByStatus:
+		cursor.Value = verification.Status().Value()
+	case SortByKind:
+		cursor.Value = verification.Kind().Value()
+	default:
+		cursor.CreatedAt = verification.CreatedAt()
+	}
+
+	return cursor
+}
+
+// VerificationFilter represents verifications listing criteria. Zero values are ignored,
+// CreatedFrom is inclusive and CreatedTo is exclusive. ExternalReference matches the reference in a client system exactly.
+type VerificationFilter struct {
+	Status            string
+	Kind              string
+	ExternalReference string
+	CreatedFrom       time.Time
+	CreatedTo         time.Time
+	Sort              VerificationSort
+	After             *VerificationCursor
+	Limit             uint
+}
@@ -0,0 +1,52 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+const (
+	// UnknownActor is recorded when state change initiator is not known.
+	UnknownActor = "unknown"
+	// SystemActorPrefix marks actors of automated state changes, e.g. "system:expiry".
+	SystemActorPrefix = "system:"
+)
+
+// IsSystemActor reports whether actor is an automated process rather than a person.
+func IsSystemActor(actor string) bool {
+	return strings.HasPrefix(actor, SystemActorPrefix)
+}
+
+// HistoryEntry represents single Verification state change: creation or status transition made by Actor.
+type HistoryEntry struct {
+	VerificationUUID string
+	Status           string
+	Reason           string
+	Actor            string
+	OccurredAt       time.Time
+}
+
+// NewHistoryEntry creates HistoryEntry of the current Verification state. Empty actor is recorded as UnknownActor.
+func NewHistoryEntry(verification *Verification, actor string, occurredAt time.Time) *HistoryEntry {
+	if actor == "" {
+		actor = UnknownActor
+	}
+
+	return &HistoryEntry{
+		VerificationUUID: verification.UUID().Value(),
+		Status:           verification.Status().Value(),
+		Reason:           verification.DeclineReason().Value(),
+		Actor:            actor,
+		OccurredAt:       occurredAt,
+	}
+}
+
+// HistoryRepository defines the expected behaviour for a verification state changes storage.
+type HistoryRepository interface {
+	Add(ctx context.Context, entry *HistoryEntry) error
+	// ListByVerification returns state changes of verification in chronological order.
+	ListByVerification(ctx context.Context, uuid VerificationUUID) ([]*HistoryEntry, error)
+}
+
+//go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=HistoryRepository --structname=VerificationHistoryRepository --filename=verification_history_repository.go
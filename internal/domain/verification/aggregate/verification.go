@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/utils"
@@ -97,9 +98,10 @@ func (d VerificationDescription) Value() string {
 var ErrInvalidVerificationStatus = errors.New("invalid verification status")
 
 const (
-	Draft    string = "draft"
-	Approved string = "approved"
-	Declined string = "declined"
+	Draft     string = "draft"
+	Approved  string = "approved"
+	Declined  string = "declined"
+	Cancelled string = "cancelled"
 )
 
 // VerificationStatus represents the verification status.
@@ -109,7 +111,7 @@ type VerificationStatus struct {
 
 // NewVerificationStatus instantiate the VO for VerificationStatus.
 func NewVerificationStatus(value string) (VerificationStatus, error) {
-	if !utils.Contains(value, []string{Draft, Approved, Declined}) {
+	if !utils.Contains(value, []string{Draft, Approved, Declined, Cancelled}) {
 		return VerificationStatus{}, ErrInvalidVerificationStatus
 	}
 
@@ -142,24 +144,60 @@ func (r VerificationDeclineReason) Value() string {
 	return r.value
 }
 
-// Verification is the data structure that represents a verification.
-type Verification struct {
-	id            VerificationID
-	uuid          VerificationUUID
-	kind          VerificationKind
-	description   VerificationDescription
-	status        VerificationStatus
-	declineReason VerificationDeclineReason
-	createdAt     time.Time
+// ExternalReferenceMaxLength is the maximum number of characters in a verification external reference.
+const ExternalReferenceMaxLength = 255
+
+var ErrExternalReferenceTooLong = errors.New("verification external reference is too long")
+
+// VerificationExternalReference represents an optional reference of the verification in a client system, e.g. an applicant ID.
+type VerificationExternalReference struct {
+	value string
 }
 
-var ErrAlreadyProcessed = errors.New("verification is already processed")
+// NewVerificationExternalReference instantiate the VO for VerificationExternalReference.
+func NewVerificationExternalReference(value string) (VerificationExternalReference, error) {
+	if utf8.RuneCountInString(value) > ExternalReferenceMaxLength {
+		return VerificationExternalReference{}, ErrExternalReferenceTooLong
+	}
+
+	return VerificationExternalReference{value: value}, nil
+}
+
+// Value return the VerificationExternalReference value.
+func (r VerificationExternalReference) Value() string {
+	return r.value
+}
+
+// Verification is the data structure that represents a verification.
+type Verification struct {
+	id                VerificationID
+	uuid              VerificationUUID
+	kind              VerificationKind
+	description       VerificationDescription
+	status            VerificationStatus
+	declineReason     VerificationDeclineReason
+	externalReference VerificationExternalReference
+	createdAt         time.Time
+	decidedAt         time.Time
+	updatedAt         time.Time
+}
+
+var (
+	ErrAlreadyProcessed          = errors.New("verification is already processed")
+	ErrVerificationAlreadyExists = errors.New("verification already exists")
+)
 
 // VerificationRepository defines the expected behaviour for a verification storage.
 type VerificationRepository interface {
+	// Add persists a new verification. It fails with ErrVerificationAlreadyExists if verification UUID is taken.
 	Add(ctx context.Context, verification *Verification) error
 	Update(ctx context.Context, verification *Verification) error
 	GetByUUID(ctx context.Context, uuid VerificationUUID) (*Verification, error)
+	// List returns at most filter.Limit verifications matching filter in filter.Sort order.
+	List(ctx context.Context, filter VerificationFilter) ([]*Verification, error)
+	// Stream calls fn for every verification matching filter in filter.Sort order without loading them all into memory.
+	// Iteration stops at the first fn error which is returned.
+	Stream(ctx context.Context, filter VerificationFilter, fn func(*Verification) error) error
 }
 
 //go:generate mockery --case=snake --outpkg=persistence --output=test/mocks/persistence --name=VerificationRepository
@@ -186,12 +224,15 @@ func NewVerification(uuid, kind, description string) (*Verification, error) {
 		return nil, err
 	}
 
+	now := time.Now()
+
 	verification := &Verification{
 		uuid:        verificationUUID,
 		kind:        verificationKind,
 		description: verificationDescription,
 		status:      verificationStatus,
-		createdAt:   time.Now(),
+		createdAt:   now,
+		updatedAt:   now,
 	}
 
 	return verification, nil
@@ -214,6 +255,33 @@ func (v *Verification) WithDeclineReason(reason string) error {
 	return nil
 }
 
+// WithExternalReference add external reference to verification.
+func (v *Verification) WithExternalReference(reference string) error {
+	externalReference, err := NewVerificationExternalReference(reference)
+	if err != nil {
+		return err
+	}
+
+	v.externalReference = externalReference
+
+	return nil
+}
+
+// WithCreatedAt add create date to verification. Used for restoring object from DB.
+func (v *Verification) WithCreatedAt(createdAt time.Time) {
+	v.createdAt = createdAt
+}
+
+// WithDecidedAt add decision date to verification. Used for restoring object from DB.
+func (v *Verification) WithDecidedAt(decidedAt time.Time) {
+	v.decidedAt = decidedAt
+}
+
+// WithUpdatedAt add last modification date to verification. Used for restoring object from DB.
+func (v *Verification) WithUpdatedAt(updatedAt time.Time) {
+	v.updatedAt = updatedAt
+}
+
 // WithStatus add status to verification. Used for restoring object from DB.
 func (v *Verification) WithStatus(status string) error {
 	verificationStatus, err := NewVerificationStatus(status)
@@ -256,11 +324,26 @@ func (v Verification) DeclineReason() VerificationDeclineReason {
 	return v.declineReason
 }
 
+// ExternalReference returns the Verification reference in a client system. It is empty if none was given.
+func (v Verification) ExternalReference() VerificationExternalReference {
+	return v.externalReference
+}
+
 // CreatedAt returns the Verification create date.
 func (v Verification) CreatedAt() time.Time {
 	return v.createdAt
 }
 
+// DecidedAt returns the date Verification was approved or declined. It is zero until decision is made.
+func (v Verification) DecidedAt() time.Time {
+	return v.decidedAt
+}
+
+// UpdatedAt returns the date Verification was last modified, it equals create date until status is changed.
+func (v Verification) UpdatedAt() time.Time {
+	return v.updatedAt
+}
+
 // Decline declines Verification with specific reason.
 func (v *Verification) Decline(declineReason string) error {
 	if v.status.value != Draft {
@@ -280,6 +363,8 @@ func (v *Verification) Decline(declineReason string) error {
 	}
 
 	v.status = verificationStatus
+	v.decidedAt = time.Now()
+	v.updatedAt = v.decidedAt
 
 	return nil
 }
@@ -297,6 +382,25 @@ func (v *Verification) Approve() error {
 	}
 
 	v.status = verificationStatus
+	v.decidedAt = time.Now()
+	v.updatedAt = v.decidedAt
+
+	return nil
+}
+
+// Cancel changes Verification status to cancelled.
+func (v *Verification) Cancel() error {
+	if v.status.value != Draft {
+		return ErrAlreadyProcessed
+	}
+
+	verificationStatus, err := NewVerificationStatus(Cancelled)
+	if err != nil {
+		return err
+	}
+
+	v.status = verificationStatus
+	v.updatedAt = time.Now()
 
 	return nil
 }
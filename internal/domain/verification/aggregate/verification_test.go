@@ -1,6 +1,7 @@
 package aggregate
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -21,12 +22,16 @@ func TestVerification(t *testing.T) {
 	t.Run("test create verification status error", testCreateInvalidVerificationStatusError)
 	t.Run("test create verification decline reason success", testCreateVerificationDeclineReasonSuccess)
 	t.Run("test create empty verification decline reason error", testCreateEmptyVerificationDeclineReasonError)
+	t.Run("test create verification external reference success", testCreateVerificationExternalReferenceSuccess)
+	t.Run("test create too long verification external reference error", testCreateTooLongVerificationExternalReferenceError)
 	t.Run("test create verification success", testCreateVerificationSuccess)
 	t.Run("test decline verification success", testDeclineVerificationSuccess)
 	t.Run("test decline already processed verification error", testDeclineAlreadyProcessedVerificationError)
 	t.Run("test decline verification with empty error", testDeclineVerificationWithEmptyReasonError)
 	t.Run("test approve verification success", testApproveVerificationSuccess)
 	t.Run("test approve already processed verification error", testApproveAlreadyProcessedVerificationError)
+	t.Run("test cancel verification success", testCancelVerificationSuccess)
+	t.Run("test cancel already processed verification error", testCancelAlreadyProcessedVerificationError)
 }
 
 func testCreateVerificationIDSuccess(t *testing.T) {
@@ -174,6 +179,7 @@ func testCreateVerificationSuccess(t *testing.T) {
 	require.Equal(t, expectedUUID.String(), verification.UUID().Value())
 	require.Equal(t, kind, verification.Kind().Value())
 	require.Equal(t, description, verification.Description().Value())
+	require.Equal(t, verification.CreatedAt(), verification.UpdatedAt())
 }
 
 func testDeclineVerificationSuccess(t *testing.T) {
@@ -191,6 +197,7 @@ func testDeclineVerificationSuccess(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, declineReason, verification.DeclineReason().Value())
 	require.Equal(t, Declined, verification.Status().Value())
+	require.False(t, verification.DecidedAt().IsZero())
 }
 
 func testDeclineAlreadyProcessedVerificationError(t *testing.T) {
@@ -239,6 +246,8 @@ func testApproveVerificationSuccess(t *testing.T) {
 	// assert
 	require.NoError(t, err)
 	require.Equal(t, Approved, verification.Status().Value())
+	require.False(t, verification.DecidedAt().IsZero())
+	require.Equal(t, verification.DecidedAt(), verification.UpdatedAt())
 }
 
 func testApproveAlreadyProcessedVerificationError(t *testing.T) {
@@ -257,3 +266,60 @@ func testApproveAlreadyProcessedVerificationError(t *testing.T) {
 	require.ErrorIs(t, err, ErrAlreadyProcessed)
 	require.Equal(t, Declined, verification.Status().Value())
 }
+
+func testCancelVerificationSuccess(t *testing.T) {
+	// assign
+	expectedUUID := uuid.New()
+	kind := Identity
+	description := "Fancy verification document description"
+
+	// act
+	verification, _ := NewVerification(expectedUUID.String(), kind, description)
+	err := verification.Cancel()
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, Cancelled, verification.Status().Value())
+	require.True(t, verification.DecidedAt().IsZero())
+	require.False(t, verification.UpdatedAt().Before(verification.CreatedAt()))
+}
+
+func testCancelAlreadyProcessedVerificationError(t *testing.T) {
+	// assign
+	expectedUUID := uuid.New()
+	kind := Identity
+	description := "Fancy verification document description"
+
+	// act
+	verification, _ := NewVerification(expectedUUID.String(), kind, description)
+	_ = verification.Approve()
+	err := verification.Cancel()
+
+	// assert
+	require.ErrorIs(t, err, ErrAlreadyProcessed)
+	require.Equal(t, Approved, verification.Status().Value())
+}
+
+func testCreateVerificationExternalReferenceSuccess(t *testing.T) {
+	// assign
+	reference := "applicant-42"
+
+	// act
+	externalReference, err := NewVerificationExternalReference(reference)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, reference, externalReference.Value())
+}
+
+func testCreateTooLongVerificationExternalReferenceError(t *testing.T) {
+	// assign
+	reference := strings.Repeat("a", ExternalReferenceMaxLength+1)
+
+	// act
+	externalReference, err := NewVerificationExternalReference(reference)
+
+	// assert
+	require.ErrorIs(t, err, ErrExternalReferenceTooLong)
+	require.Equal(t, VerificationExternalReference{}, externalReference)
+}
@@ -9,17 +9,22 @@ import (
 // ApproveVerificationService is the default Verification approve service
 type ApproveVerificationService struct {
 	verificationRepository aggregate.VerificationRepository
+	historyRepository      aggregate.HistoryRepository
 }
 
 // NewApproveVerificationService returns the default CreateVerificationService interface implementation
-func NewApproveVerificationService(verificationRepository aggregate.VerificationRepository) ApproveVerificationService {
+func NewApproveVerificationService(
+	verificationRepository aggregate.VerificationRepository,
+	historyRepository aggregate.HistoryRepository,
+) ApproveVerificationService {
 	return ApproveVerificationService{
 		verificationRepository: verificationRepository,
+		historyRepository:      historyRepository,
 	}
 }
 
-// Approve implements the ApproveVerificationService interface
-func (s ApproveVerificationService) Approve(ctx context.Context, uuid string) error {
+// Approve implements the ApproveVerificationService interface. Approval made by actor is recorded to history.
+func (s ApproveVerificationService) Approve(ctx context.Context, uuid, actor string) error {
 	verificationUUID, err := aggregate.NewVerificationUUID(uuid)
 	if err != nil {
 		return err
@@ -34,5 +39,9 @@ func (s ApproveVerificationService) Approve(ctx context.Context, uuid string) er
 		return err
 	}
 
-	return s.verificationRepository.Update(ctx, verification)
+	if err := s.verificationRepository.Update(ctx, verification); err != nil {
+		return err
+	}
+
+	return s.historyRepository.Add(ctx, aggregate.NewHistoryEntry(verification, actor, verification.DecidedAt()))
 }
@@ -18,11 +18,13 @@ func TestApproveVerificationServiceInvalidUUIDError(t *testing.T) {
 
 	// act
 	verificationRepositoryMock := new(persistence.VerificationRepository)
-	approveVerificationService := NewApproveVerificationService(verificationRepositoryMock)
-	err := approveVerificationService.Approve(context.Background(), verificationUUID)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	approveVerificationService := NewApproveVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := approveVerificationService.Approve(context.Background(), verificationUUID, testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, aggregate.ErrInvalidVerificationUUID)
 }
 
@@ -31,14 +33,16 @@ func TestApproveVerificationServiceNotFoundError(t *testing.T) {
 	verificationUUID := uuid.New()
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(nil, postgres.ErrVerificationNotFound)
 
 	// act
-	approveVerificationService := NewApproveVerificationService(verificationRepositoryMock)
-	err := approveVerificationService.Approve(context.Background(), verificationUUID.String())
+	approveVerificationService := NewApproveVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := approveVerificationService.Approve(context.Background(), verificationUUID.String(), testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, postgres.ErrVerificationNotFound)
 }
 
@@ -52,14 +56,16 @@ func TestApproveVerificationServiceAlreadyProcessedError(t *testing.T) {
 	_ = processedVerification.Approve()
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(processedVerification, nil)
 
 	// act
-	approveVerificationService := NewApproveVerificationService(verificationRepositoryMock)
-	err := approveVerificationService.Approve(context.Background(), processedVerification.UUID().Value())
+	approveVerificationService := NewApproveVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := approveVerificationService.Approve(context.Background(), processedVerification.UUID().Value(), testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, aggregate.ErrAlreadyProcessed)
 }
 
@@ -72,15 +78,18 @@ func TestApproveVerificationServiceSuccess(t *testing.T) {
 	)
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(verification, nil)
 	verificationRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	historyRepositoryMock.On("Add", mock.Anything, mock.Anything).Return(nil)
 
 	// act
-	approveVerificationService := NewApproveVerificationService(verificationRepositoryMock)
-	err := approveVerificationService.Approve(context.Background(), verification.UUID().Value())
+	approveVerificationService := NewApproveVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := approveVerificationService.Approve(context.Background(), verification.UUID().Value(), testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.NoError(t, err)
 	assert.Equal(t, aggregate.Approved, verification.Status().Value())
 }
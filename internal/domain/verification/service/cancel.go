@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+// CancelVerificationService is the default Verification cancel service
+type CancelVerificationService struct {
+	verificationRepository aggregate.VerificationRepository
+	historyRepository      aggregate.HistoryRepository
+}
+
+// NewCancelVerificationService returns the default CancelVerificationService interface implementation
+func NewCancelVerificationService(
+	verificationRepository aggregate.VerificationRepository,
+	historyRepository aggregate.HistoryRepository,
+) CancelVerificationService {
+	return CancelVerificationService{
+		verificationRepository: verificationRepository,
+		historyRepository:      historyRepository,
+	}
+}
+
+// Cancel implements the CancelVerificationService interface. Cancellation made by actor is recorded to history.
+func (s CancelVerificationService) Cancel(ctx context.Context, uuid, actor string) error {
+	verificationUUID, err := aggregate.NewVerificationUUID(uuid)
+	if err != nil {
+		return err
+	}
+
+	verification, err := s.verificationRepository.GetByUUID(ctx, verificationUUID)
+	if err != nil {
+		return err
+	}
+
+	if err := verification.Cancel(); err != nil {
+		return err
+	}
+
+	if err := s.verificationRepository.Update(ctx, verification); err != nil {
+		return err
+	}
+
+	return s.historyRepository.Add(ctx, aggregate.NewHistoryEntry(verification, actor, time.Now()))
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestCancelVerificationServiceInvalidUUIDError(t *testing.T) {
+	// assign
+	verificationUUID := "invalidUUID"
+
+	// act
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	cancelVerificationService := NewCancelVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := cancelVerificationService.Cancel(context.Background(), verificationUUID, testActor)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, aggregate.ErrInvalidVerificationUUID)
+}
+
+func TestCancelVerificationServiceNotFoundError(t *testing.T) {
+	// assign
+	verificationUUID := uuid.New()
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(nil, postgres.ErrVerificationNotFound)
+
+	// act
+	cancelVerificationService := NewCancelVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := cancelVerificationService.Cancel(context.Background(), verificationUUID.String(), testActor)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, postgres.ErrVerificationNotFound)
+}
+
+func TestCancelVerificationServiceAlreadyProcessedError(t *testing.T) {
+	// assign
+	processedVerification, _ := aggregate.NewVerification(
+		uuid.New().String(),
+		aggregate.Identity,
+		"Fancy verification document description",
+	)
+	_ = processedVerification.Approve()
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(processedVerification, nil)
+
+	// act
+	cancelVerificationService := NewCancelVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := cancelVerificationService.Cancel(context.Background(), processedVerification.UUID().Value(), testActor)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, aggregate.ErrAlreadyProcessed)
+}
+
+func TestCancelVerificationServiceSuccess(t *testing.T) {
+	// assign
+	verification, _ := aggregate.NewVerification(
+		uuid.New().String(),
+		aggregate.Identity,
+		"Fancy verification document description",
+	)
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(verification, nil)
+	verificationRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	historyRepositoryMock.On("Add", mock.Anything, mock.MatchedBy(func(entry *aggregate.HistoryEntry) bool {
+		return entry.Status == aggregate.Cancelled && entry.Actor == testActor
+	})).Return(nil)
+
+	// act
+	cancelVerificationService := NewCancelVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := cancelVerificationService.Cancel(context.Background(), verification.UUID().Value(), testActor)
+
+	// assert
+	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Equal(t, aggregate.Cancelled, verification.Status().Value())
+}
@@ -10,21 +10,34 @@ import (
 // CreateVerificationService is the default Verification create service
 type CreateVerificationService struct {
 	verificationRepository aggregate.VerificationRepository
+	historyRepository      aggregate.HistoryRepository
 }
 
 // NewCreateVerificationService returns the default CreateVerificationService interface implementation
-func NewCreateVerificationService(verificationRepository aggregate.VerificationRepository) CreateVerificationService {
+func NewCreateVerificationService(
+	verificationRepository aggregate.VerificationRepository,
+	historyRepository aggregate.HistoryRepository,
+) CreateVerificationService {
 	return CreateVerificationService{
 		verificationRepository: verificationRepository,
+		historyRepository:      historyRepository,
 	}
 }
 
-// Create implements the CreateVerificationService interface
-func (s CreateVerificationService) Create(ctx context.Context, uuid uuid.UUID, description, kind string) error {
+// Create implements the CreateVerificationService interface. Creation made by actor is recorded to history.
+func (s CreateVerificationService) Create(ctx context.Context, uuid uuid.UUID, description, kind, externalReference, actor string) error {
 	verification, err := aggregate.NewVerification(uuid.String(), kind, description)
 	if err != nil {
 		return err
 	}
 
-	return s.verificationRepository.Add(ctx, verification)
+	if err := verification.WithExternalReference(externalReference); err != nil {
+		return err
+	}
+
+	if err := s.verificationRepository.Add(ctx, verification); err != nil {
+		return err
+	}
+
+	return s.historyRepository.Add(ctx, aggregate.NewHistoryEntry(verification, actor, verification.CreatedAt()))
 }
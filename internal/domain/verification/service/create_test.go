@@ -12,6 +12,11 @@ import (
 	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
 )
 
+const (
+	testActor             = "agent@example.com"
+	testExternalReference = "applicant-42"
+)
+
 func TestCreateVerificationServiceDomainError(t *testing.T) {
 	// assign
 	verificationUUID := uuid.New()
@@ -20,11 +25,13 @@ func TestCreateVerificationServiceDomainError(t *testing.T) {
 
 	// act
 	verificationRepositoryMock := new(persistence.VerificationRepository)
-	createVerificationService := NewCreateVerificationService(verificationRepositoryMock)
-	err := createVerificationService.Create(context.Background(), verificationUUID, description, kind)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	createVerificationService := NewCreateVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := createVerificationService.Create(context.Background(), verificationUUID, description, kind, testExternalReference, testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, aggregate.ErrEmptyDescription)
 }
 
@@ -35,14 +42,16 @@ func TestCreateVerificationServicePersistenceError(t *testing.T) {
 	kind := aggregate.Document
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("Add", mock.Anything, mock.Anything).Return(postgres.ErrVerificationPersistFailed)
 
 	// act
-	createVerificationService := NewCreateVerificationService(verificationRepositoryMock)
-	err := createVerificationService.Create(context.Background(), verificationUUID, description, kind)
+	createVerificationService := NewCreateVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := createVerificationService.Create(context.Background(), verificationUUID, description, kind, testExternalReference, testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, postgres.ErrVerificationPersistFailed)
 }
 
@@ -53,13 +62,22 @@ func TestCreateVerificationServiceSuccess(t *testing.T) {
 	kind := aggregate.Identity
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
-	verificationRepositoryMock.On("Add", mock.Anything, mock.Anything).Return(nil)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	verificationRepositoryMock.On("Add", mock.Anything, mock.MatchedBy(func(verification *aggregate.Verification) bool {
+		return verification.ExternalReference().Value() == testExternalReference
+	})).Return(nil)
+	historyRepositoryMock.On("Add", mock.Anything, mock.MatchedBy(func(entry *aggregate.HistoryEntry) bool {
+		return entry.VerificationUUID == verificationUUID.String() &&
+			entry.Status == aggregate.Draft &&
+			entry.Actor == testActor
+	})).Return(nil)
 
 	// act
-	createVerificationService := NewCreateVerificationService(verificationRepositoryMock)
-	err := createVerificationService.Create(context.Background(), verificationUUID, description, kind)
+	createVerificationService := NewCreateVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := createVerificationService.Create(context.Background(), verificationUUID, description, kind, testExternalReference, testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.NoError(t, err)
 }
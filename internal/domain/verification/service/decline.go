@@ -9,17 +9,22 @@ import (
 // DeclineVerificationService is the default Verification decline service.
 type DeclineVerificationService struct {
 	verificationRepository aggregate.VerificationRepository
+	historyRepository      aggregate.HistoryRepository
 }
 
 // NewDeclineVerificationService returns the default DeclineVerificationService interface implementation.
-func NewDeclineVerificationService(verificationRepository aggregate.VerificationRepository) DeclineVerificationService {
+func NewDeclineVerificationService(
+	verificationRepository aggregate.VerificationRepository,
+	historyRepository aggregate.HistoryRepository,
+) DeclineVerificationService {
 	return DeclineVerificationService{
 		verificationRepository: verificationRepository,
+		historyRepository:      historyRepository,
 	}
 }
 
-// Decline implements the DeclineVerificationService interface
-func (s DeclineVerificationService) Decline(ctx context.Context, uuid, declineReason string) error {
+// Decline implements the DeclineVerificationService interface. Decline made by actor is recorded to history.
+func (s DeclineVerificationService) Decline(ctx context.Context, uuid, declineReason, actor string) error {
 	verificationUUID, err := aggregate.NewVerificationUUID(uuid)
 	if err != nil {
 		return err
@@ -34,5 +39,9 @@ func (s DeclineVerificationService) Decline(ctx context.Context, uuid, declineRe
 		return err
 	}
 
-	return s.verificationRepository.Update(ctx, verification)
+	if err := s.verificationRepository.Update(ctx, verification); err != nil {
+		return err
+	}
+
+	return s.historyRepository.Add(ctx, aggregate.NewHistoryEntry(verification, actor, verification.DecidedAt()))
 }
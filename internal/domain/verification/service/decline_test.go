@@ -19,11 +19,13 @@ func TestDeclineVerificationServiceInvalidUUIDError(t *testing.T) {
 
 	// act
 	verificationRepositoryMock := new(persistence.VerificationRepository)
-	declineVerificationService := NewDeclineVerificationService(verificationRepositoryMock)
-	err := declineVerificationService.Decline(context.Background(), verificationUUID, declineReason)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
+	declineVerificationService := NewDeclineVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := declineVerificationService.Decline(context.Background(), verificationUUID, declineReason, testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, aggregate.ErrInvalidVerificationUUID)
 }
 
@@ -33,14 +35,16 @@ func TestDeclineVerificationServiceNotFoundError(t *testing.T) {
 	declineReason := "Bad document quantity"
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(nil, postgres.ErrVerificationNotFound)
 
 	// act
-	declineVerificationService := NewDeclineVerificationService(verificationRepositoryMock)
-	err := declineVerificationService.Decline(context.Background(), verificationUUID.String(), declineReason)
+	declineVerificationService := NewDeclineVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := declineVerificationService.Decline(context.Background(), verificationUUID.String(), declineReason, testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, postgres.ErrVerificationNotFound)
 }
 
@@ -55,14 +59,16 @@ func TestDeclineVerificationServiceAlreadyProcessedError(t *testing.T) {
 	_ = processedVerification.Approve()
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(processedVerification, nil)
 
 	// act
-	declineVerificationService := NewDeclineVerificationService(verificationRepositoryMock)
-	err := declineVerificationService.Decline(context.Background(), processedVerification.UUID().Value(), declineReason)
+	declineVerificationService := NewDeclineVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := declineVerificationService.Decline(context.Background(), processedVerification.UUID().Value(), declineReason, testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.ErrorIs(t, err, aggregate.ErrAlreadyProcessed)
 }
 
@@ -76,15 +82,23 @@ func TestDeclineVerificationServiceSuccess(t *testing.T) {
 	)
 
 	verificationRepositoryMock := new(persistence.VerificationRepository)
+	historyRepositoryMock := new(persistence.VerificationHistoryRepository)
 	verificationRepositoryMock.On("GetByUUID", mock.Anything, mock.Anything).Return(verification, nil)
 	verificationRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	historyRepositoryMock.On("Add", mock.Anything, mock.MatchedBy(func(entry *aggregate.HistoryEntry) bool {
+		return entry.Status == aggregate.Declined &&
+			entry.Reason == declineReason &&
+			entry.Actor == testActor &&
+			entry.OccurredAt.Equal(verification.DecidedAt())
+	})).Return(nil)
 
 	// act
-	declineVerificationService := NewDeclineVerificationService(verificationRepositoryMock)
-	err := declineVerificationService.Decline(context.Background(), verification.UUID().Value(), declineReason)
+	declineVerificationService := NewDeclineVerificationService(verificationRepositoryMock, historyRepositoryMock)
+	err := declineVerificationService.Decline(context.Background(), verification.UUID().Value(), declineReason, testActor)
 
 	// assert
 	verificationRepositoryMock.AssertExpectations(t)
+	historyRepositoryMock.AssertExpectations(t)
 	assert.NoError(t, err)
 	assert.Equal(t, aggregate.Declined, verification.Status().Value())
 }
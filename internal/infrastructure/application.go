@@ -8,35 +8,54 @@ import (
 	"log"
 	"net/http"
 	"reflect"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/transaction"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/utils"
 )
 
-const requestMaxBodySizeInBytes = 1048576
+const (
+	requestMaxBodySizeInBytes = 1048576
+	preferRespondAsync        = "respond-async"
+	actorHeader               = "X-Actor"
+	actorMaxLength            = 255
+)
 
 var (
 	ErrMultipleRequestJsonObjects = errors.New("request body must contain a single JSON object")
 	ErrInternal                   = errors.New("internal error")
 	ErrMarshalFailed              = errors.New("marshal failed")
 	ErrEmptyRequestBody           = errors.New("request body must not be empty")
+	ErrInvalidRequestBody         = errors.New("request body is malformed")
 )
 
 // Application represents container for top level services used in handlers.
 type Application struct {
-	CommandBus bus.CommandBus
-	QueryBus   bus.QueryBus
-	Validator  *validator.Validate
+	CommandBus      bus.CommandBus
+	AsyncCommandBus bus.AsyncCommandBus
+	QueryBus        bus.QueryBus
+	UnitOfWork      transaction.UnitOfWork
+	Validator       *validator.Validate
 }
 
 // NewApplication creates a new Application.
-func NewApplication(commandBus bus.CommandBus, queryBus bus.QueryBus, validator *validator.Validate) *Application {
+func NewApplication(
+	commandBus bus.CommandBus,
+	asyncCommandBus bus.AsyncCommandBus,
+	queryBus bus.QueryBus,
+	unitOfWork transaction.UnitOfWork,
+	validator *validator.Validate,
+) *Application {
 	return &Application{
-		CommandBus: commandBus,
-		QueryBus:   queryBus,
-		Validator:  validator,
+		CommandBus:      commandBus,
+		AsyncCommandBus: asyncCommandBus,
+		QueryBus:        queryBus,
+		UnitOfWork:      unitOfWork,
+		Validator:       validator,
 	}
 }
 
@@ -45,6 +64,44 @@ func (a *Application) GetURLParam(r *http.Request, name string) string {
 	return chi.URLParam(r, name)
 }
 
+// RespondAsync reports whether client asked to handle request in background with "Prefer: respond-async" header.
+func (a *Application) RespondAsync(r *http.Request) bool {
+	for _, value := range r.Header.Values("Prefer") {
+		for _, preference := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(preference), preferRespondAsync) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Actor returns initiator of request given in "X-Actor" header, e.g. support agent email.
+// Empty string is returned when header is missing or claims to be an automated process.
+// The header is not authenticated, so such actors are reported as unverified in verification timeline.
+func (a *Application) Actor(r *http.Request) string {
+	actor := strings.TrimSpace(r.Header.Get(actorHeader))
+	if aggregate.IsSystemActor(actor) {
+		return ""
+	}
+
+	if runes := []rune(actor); len(runes) > actorMaxLength {
+		actor = string(runes[:actorMaxLength])
+	}
+
+	return actor
+}
+
+// AcceptedResponse write accepted background job to response with http.StatusAccepted status code.
+func (a *Application) AcceptedResponse(w http.ResponseWriter, r *http.Request, jobID string) {
+	headers := http.Header{"Location": []string{APIPath(r, fmt.Sprintf("/jobs/%s", jobID))}}
+
+	if err := a.Marshall(w, http.StatusAccepted, NewJobAcceptedResponse(jobID), headers); err != nil {
+		a.HttpErrorResponse(w, r, err)
+	}
+}
+
 // Marshall serializes response data to json with specific status code.
 func (a *Application) Marshall(w http.ResponseWriter, status int, data any, headers http.Header) error {
 	content, err := json.Marshal(data)
@@ -76,7 +133,7 @@ func (a *Application) Unmarshall(w http.ResponseWriter, r *http.Request, destina
 		case errors.Is(err, io.EOF):
 			return ErrEmptyRequestBody
 		default:
-			return fmt.Errorf("unmarshalling data of type %s failed: %v", reflect.TypeOf(destination), err)
+			return fmt.Errorf("%w: %s", ErrInvalidRequestBody, err)
 		}
 	}
 
@@ -87,16 +144,55 @@ func (a *Application) Unmarshall(w http.ResponseWriter, r *http.Request, destina
 	return nil
 }
 
-// HttpErrorResponse write error to response with http.StatusBadRequest status code.
-func (a *Application) HttpErrorResponse(w http.ResponseWriter, err error) {
-	marshalErr := a.Marshall(w, http.StatusBadRequest, NewHttpErrorResponse(err.Error()), nil)
+// HttpErrorResponse write error to response with status code and stable code given by ClassifyError.
+// Command and query validation errors are written in ValidationErrorResponse format.
+// Internal errors are logged and hidden from client behind generic message.
+// Response format is negotiated with "Accept" header, see AcceptsProblem.
+func (a *Application) HttpErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	var busValidationError *bus.ValidationError
+	if errors.As(err, &busValidationError) {
+		a.ValidationErrorResponse(w, r, err)
+
+		return
+	}
+
+	status, code, message := a.classifyError(err)
+
+	a.writeErrorResponse(w, r, status, code, message)
+}
+
+// ErrorMessage returns message of err safe to show to client. Internal errors are logged.
+func (a *Application) ErrorMessage(err error) string {
+	_, _, message := a.classifyError(err)
+
+	return message
+}
+
+// classifyError classifies err with ClassifyError and logs internal errors.
+func (a *Application) classifyError(err error) (int, string, string) {
+	status, code, message := ClassifyError(err)
+	if status == http.StatusInternalServerError {
+		log.Printf("%s: %s", ErrInternal, err)
+	}
+
+	return status, code, message
+}
+
+// ErrorResponse write error to response with specific status code. Stable code is given by ErrorCode.
+func (a *Application) ErrorResponse(w http.ResponseWriter, r *http.Request, status int, err error) {
+	a.writeErrorResponse(w, r, status, ErrorCode(err, status), err.Error())
+}
+
+// writeErrorResponse writes error message with status code and stable code, falling back to internal error.
+func (a *Application) writeErrorResponse(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	marshalErr := writeErrorResponse(w, r, status, code, message)
 
 	if marshalErr == nil {
 		return
 	}
 
 	log.Printf("%s: %s", ErrMarshalFailed, marshalErr)
-	_ = a.Marshall(w, http.StatusInternalServerError, NewHttpErrorResponse(ErrInternal.Error()), nil)
+	_ = writeErrorResponse(w, r, http.StatusInternalServerError, CodeInternalError, ErrInternal.Error())
 }
 
 // ValidateRequest validate request struct with usage of Validator.
@@ -104,13 +200,54 @@ func (a *Application) ValidateRequest(s interface{}) error {
 	return a.Validator.Struct(s)
 }
 
-// ValidationErrorResponse write validation errors to response.
-func (a *Application) ValidationErrorResponse(w http.ResponseWriter, err error) {
-	var validationErrors []ValidationError
+// ValidationErrorResponse write request validator or command and query validation errors to response
+// with http.StatusUnprocessableEntity status code. Problem format lists stable code of every invalid field.
+func (a *Application) ValidationErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	var (
+		validationErrors   []ProblemValidationError
+		busValidationError *bus.ValidationError
+	)
+
+	if errors.As(err, &busValidationError) {
+		for _, fieldError := range busValidationError.Errors {
+			validationErrors = append(validationErrors, ProblemValidationError{
+				Code:         fieldErrorCode(fieldError.Err),
+				Detail:       fieldError.Message,
+				PropertyPath: fieldError.Field,
+			})
+		}
+	} else {
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, ProblemValidationError{
+				Code:         validatorErrorCode(err.Tag()),
+				Detail:       err.Error(),
+				PropertyPath: utils.LcFirst(err.Field()),
+			})
+		}
+	}
+
+	if !AcceptsProblem(r) {
+		legacyErrors := make([]ValidationError, len(validationErrors))
+		for i, validationError := range validationErrors {
+			legacyErrors[i] = ValidationError{validationError.Detail, validationError.PropertyPath}
+		}
+
+		_ = a.Marshall(w, http.StatusUnprocessableEntity, NewValidationErrorResponse(legacyErrors), nil)
+
+		return
+	}
+
+	problem := NewProblem(r, http.StatusUnprocessableEntity, CodeValidationFailed, bus.ErrValidationFailed.Error())
+	problem.Errors = validationErrors
+
+	_ = writeJSON(w, http.StatusUnprocessableEntity, ProblemContentType, problem)
+}
 
-	for _, err := range err.(validator.ValidationErrors) {
-		validationErrors = append(validationErrors, ValidationError{err.Error(), utils.LcFirst(err.Field())})
+// validatorErrorCode returns stable code of request validator tag.
+func validatorErrorCode(tag string) string {
+	if strings.HasPrefix(tag, "required") {
+		return CodeRequired
 	}
 
-	_ = a.Marshall(w, http.StatusBadRequest, NewValidationErrorResponse(validationErrors), nil)
+	return CodeInvalidValue
 }
@@ -0,0 +1,145 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+// asyncJob represents command waiting in AsyncCommandBus queue together with its job.
+type asyncJob struct {
+	job     *bus.Job
+	command bus.Command
+}
+
+// AsyncCommandBus represents bounded worker pool implementation of bus.AsyncCommandBus interface.
+// Commands are handled in background by wrapped bus.CommandBus, job status is stored in bus.JobRepository.
+type AsyncCommandBus struct {
+	commandBus    bus.CommandBus
+	jobRepository bus.JobRepository
+	queue         chan asyncJob
+	mu            sync.RWMutex
+	closed        bool
+	wg            sync.WaitGroup
+}
+
+// NewAsyncCommandBus creates a new AsyncCommandBus and starts its workers.
+func NewAsyncCommandBus(commandBus bus.CommandBus, jobRepository bus.JobRepository, workers, queueSize uint) *AsyncCommandBus {
+	b := &AsyncCommandBus{
+		commandBus:    commandBus,
+		jobRepository: jobRepository,
+		queue:         make(chan asyncJob, queueSize),
+	}
+
+	for i := uint(0); i < workers; i++ {
+		b.wg.Add(1)
+
+		go b.work()
+	}
+
+	return b
+}
+
+// Dispatch implements bus.CommandBus.Dispatch method. Command is enqueued and handled in background.
+func (b *AsyncCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	_, err := b.Enqueue(ctx, command)
+
+	return err
+}
+
+// Register implements bus.CommandBus.Register method.
+func (b *AsyncCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	return b.commandBus.Register(commandType, handler)
+}
+
+// Enqueue implements bus.AsyncCommandBus.Enqueue method.
+// Command is validated before job is created, so invalid commands are rejected synchronously.
+func (b *AsyncCommandBus) Enqueue(ctx context.Context, command bus.Command) (string, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return "", fmt.Errorf("%s: %w", command.Type(), bus.ErrCommandBusClosed)
+	}
+
+	if err := bus.Validate(command); err != nil {
+		return "", err
+	}
+
+	job := bus.NewJob(uuid.New().String(), command.Type())
+
+	if err := b.jobRepository.Add(ctx, job); err != nil {
+		return "", err
+	}
+
+	select {
+	case b.queue <- asyncJob{job: job, command: command}:
+		return job.ID, nil
+	default:
+		job.Fail(bus.ErrCommandQueueFull)
+		b.updateJob(ctx, job)
+
+		return "", fmt.Errorf("%s: %w", command.Type(), bus.ErrCommandQueueFull)
+	}
+}
+
+// Shutdown stops accepting new commands and waits until already enqueued jobs are handled.
+func (b *AsyncCommandBus) Shutdown(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		close(b.queue)
+	}
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// work handles queued jobs until queue is closed.
+func (b *AsyncCommandBus) work() {
+	defer b.wg.Done()
+
+	for j := range b.queue {
+		b.handle(j)
+	}
+}
+
+// handle dispatches job command to wrapped bus.CommandBus and stores job result.
+// Request context is already gone at this point, so the background one is used.
+func (b *AsyncCommandBus) handle(j asyncJob) {
+	ctx := context.Background()
+
+	j.job.Start()
+	b.updateJob(ctx, j.job)
+
+	if err := b.commandBus.Dispatch(ctx, j.command); err != nil {
+		j.job.Fail(err)
+	} else {
+		j.job.Succeed(j.command)
+	}
+
+	b.updateJob(ctx, j.job)
+}
+
+// updateJob persists job status. Failure is only logged because there is nobody to return it to.
+func (b *AsyncCommandBus) updateJob(ctx context.Context, job *bus.Job) {
+	if err := b.jobRepository.Update(ctx, job); err != nil {
+		log.Printf("job %s status %s update failed: %s", job.ID, job.Status, err)
+	}
+}
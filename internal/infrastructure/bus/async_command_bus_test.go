@@ -0,0 +1,154 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+const testCommandType bus.CommandType = "test.command"
+
+var errTestHandlerFailed = errors.New("test handler failed")
+
+type testCommand struct {
+	UUID string
+}
+
+func (c testCommand) Type() bus.CommandType {
+	return testCommandType
+}
+
+func (c testCommand) AggregateID() string {
+	return c.UUID
+}
+
+type testCommandHandler struct {
+	err     error
+	started chan struct{}
+	release chan struct{}
+}
+
+func (h testCommandHandler) Handle(_ context.Context, _ bus.Command) error {
+	if h.started != nil {
+		h.started <- struct{}{}
+	}
+
+	if h.release != nil {
+		<-h.release
+	}
+
+	return h.err
+}
+
+type inMemoryJobRepository struct {
+	mu   sync.Mutex
+	jobs map[string]bus.Job
+}
+
+func newInMemoryJobRepository() *inMemoryJobRepository {
+	return &inMemoryJobRepository{jobs: make(map[string]bus.Job)}
+}
+
+func (r *inMemoryJobRepository) Add(_ context.Context, job *bus.Job) error {
+	return r.Update(context.Background(), job)
+}
+
+func (r *inMemoryJobRepository) Update(_ context.Context, job *bus.Job) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.jobs[job.ID] = *job
+
+	return nil
+}
+
+func (r *inMemoryJobRepository) GetByID(_ context.Context, id string) (*bus.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job := r.jobs[id]
+
+	return &job, nil
+}
+
+func TestAsyncCommandBusHandleSuccess(t *testing.T) {
+	// assign
+	jobRepository := newInMemoryJobRepository()
+	asyncCommandBus := NewAsyncCommandBus(NewInMemoryCommandBus(), jobRepository, 2, 10)
+	require.NoError(t, asyncCommandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	jobID, err := asyncCommandBus.Enqueue(context.Background(), testCommand{UUID: "1"})
+	require.NoError(t, err)
+	require.NoError(t, asyncCommandBus.Shutdown(context.Background()))
+
+	// assert
+	job, _ := jobRepository.GetByID(context.Background(), jobID)
+	assert.Equal(t, bus.JobSucceeded, job.Status)
+	assert.Equal(t, testCommandType, job.CommandType)
+	assert.Equal(t, "1", job.Result)
+}
+
+func TestAsyncCommandBusHandleError(t *testing.T) {
+	// assign
+	jobRepository := newInMemoryJobRepository()
+	asyncCommandBus := NewAsyncCommandBus(NewInMemoryCommandBus(), jobRepository, 1, 10)
+	require.NoError(t, asyncCommandBus.Register(testCommandType, testCommandHandler{err: errTestHandlerFailed}))
+
+	// act
+	jobID, err := asyncCommandBus.Enqueue(context.Background(), testCommand{})
+	require.NoError(t, err)
+	require.NoError(t, asyncCommandBus.Shutdown(context.Background()))
+
+	// assert
+	job, _ := jobRepository.GetByID(context.Background(), jobID)
+	assert.Equal(t, bus.JobFailed, job.Status)
+	assert.Equal(t, errTestHandlerFailed.Error(), job.Error)
+}
+
+func TestAsyncCommandBusQueueFullError(t *testing.T) {
+	// assign
+	handler := testCommandHandler{started: make(chan struct{}), release: make(chan struct{})}
+
+	jobRepository := newInMemoryJobRepository()
+	asyncCommandBus := NewAsyncCommandBus(NewInMemoryCommandBus(), jobRepository, 1, 1)
+	require.NoError(t, asyncCommandBus.Register(testCommandType, handler))
+
+	// act
+	_, err := asyncCommandBus.Enqueue(context.Background(), testCommand{})
+	require.NoError(t, err)
+	<-handler.started
+
+	_, err = asyncCommandBus.Enqueue(context.Background(), testCommand{})
+	require.NoError(t, err)
+
+	rejectedJobID, err := asyncCommandBus.Enqueue(context.Background(), testCommand{})
+
+	close(handler.release)
+	go func() {
+		<-handler.started
+	}()
+	require.NoError(t, asyncCommandBus.Shutdown(context.Background()))
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrCommandQueueFull)
+	assert.Empty(t, rejectedJobID)
+}
+
+func TestAsyncCommandBusClosedError(t *testing.T) {
+	// assign
+	asyncCommandBus := NewAsyncCommandBus(NewInMemoryCommandBus(), newInMemoryJobRepository(), 1, 1)
+	require.NoError(t, asyncCommandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	require.NoError(t, asyncCommandBus.Shutdown(context.Background()))
+	err := asyncCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrCommandBusClosed)
+}
@@ -0,0 +1,163 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/transaction"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/cache"
+)
+
+// QueryCacheStats represents CachingQueryBus usage counters.
+type QueryCacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	Size   int    `json:"size"`
+}
+
+// CachingQueryBus represents bus.QueryBus decorator caching query results in bounded in memory LRU.
+// Only query types with configured TTL are cached, results are keyed by query type and query parameters.
+// Results pointing to struct, e.g. *aggregate.Verification, are copied, so callers never share cached value.
+type CachingQueryBus struct {
+	queryBus bus.QueryBus
+	cache    *cache.LRU
+	ttls     map[bus.QueryType]time.Duration
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+}
+
+// NewCachingQueryBus creates a new CachingQueryBus.
+func NewCachingQueryBus(queryBus bus.QueryBus, lru *cache.LRU, ttls map[bus.QueryType]time.Duration) *CachingQueryBus {
+	return &CachingQueryBus{
+		queryBus: queryBus,
+		cache:    lru,
+		ttls:     ttls,
+	}
+}
+
+// Ask implements bus.QueryBus.Ask method. Errors are never cached.
+func (b *CachingQueryBus) Ask(ctx context.Context, query bus.Query) (any, error) {
+	ttl, ok := b.ttls[query.Type()]
+	if !ok {
+		return b.queryBus.Ask(ctx, query)
+	}
+
+	key := queryCacheKey(query)
+
+	if result, ok := b.cache.Get(key); ok {
+		b.hits.Add(1)
+
+		return copyResult(result), nil
+	}
+
+	b.misses.Add(1)
+
+	result, err := b.queryBus.Ask(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var tag string
+	if aggregateAware, ok := query.(bus.AggregateAware); ok {
+		tag = aggregateTag(aggregateAware.AggregateID())
+	}
+
+	b.cache.Set(key, copyResult(result), ttl, tag)
+
+	return result, nil
+}
+
+// Register implements bus.QueryBus.Register method.
+func (b *CachingQueryBus) Register(queryType bus.QueryType, handler bus.QueryHandler) error {
+	return b.queryBus.Register(queryType, handler)
+}
+
+// Invalidate removes every cached result of queries related to aggregate.
+func (b *CachingQueryBus) Invalidate(aggregateID string) {
+	b.cache.InvalidateTag(aggregateTag(aggregateID))
+}
+
+// Stats returns cache usage counters.
+func (b *CachingQueryBus) Stats() QueryCacheStats {
+	return QueryCacheStats{
+		Hits:   b.hits.Load(),
+		Misses: b.misses.Load(),
+		Size:   b.cache.Len(),
+	}
+}
+
+// queryCacheKey builds cache key from query type and query parameters.
+// Aggregate identifiers must be canonical in query parameters, e.g. lowercase uuid, to share cached results.
+func queryCacheKey(query bus.Query) string {
+	return fmt.Sprintf("%s:%+v", query.Type(), query)
+}
+
+// aggregateTag returns canonical form of aggregate identifier, so uuid in any letter case tags the same results.
+func aggregateTag(aggregateID string) string {
+	if parsed, err := uuid.Parse(aggregateID); err == nil {
+		return parsed.String()
+	}
+
+	return aggregateID
+}
+
+// copyResult returns a copy of result pointing to struct and other results as is.
+// Copy is shallow, so such results must keep reference types, e.g. maps or slices, immutable.
+func copyResult(result any) any {
+	value := reflect.ValueOf(result)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return result
+	}
+
+	copied := reflect.New(value.Elem().Type())
+	copied.Elem().Set(value.Elem())
+
+	return copied.Interface()
+}
+
+// CacheInvalidatingCommandBus represents bus.CommandBus decorator invalidating cached query results
+// related to the same aggregate as dispatched command.
+type CacheInvalidatingCommandBus struct {
+	commandBus bus.CommandBus
+	queryBus   *CachingQueryBus
+	notifier   transaction.Notifier
+}
+
+// NewCacheInvalidatingCommandBus creates a new CacheInvalidatingCommandBus.
+func NewCacheInvalidatingCommandBus(
+	commandBus bus.CommandBus,
+	queryBus *CachingQueryBus,
+	notifier transaction.Notifier,
+) CacheInvalidatingCommandBus {
+	return CacheInvalidatingCommandBus{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		notifier:   notifier,
+	}
+}
+
+// Dispatch implements bus.CommandBus.Dispatch method.
+// Cache is invalidated even if command failed because handler could have changed aggregate partially.
+// Command dispatched inside outer transaction, e.g. of atomic batch, invalidates cache once it is committed,
+// otherwise a read made before commit would cache the old state again.
+func (b CacheInvalidatingCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	err := b.commandBus.Dispatch(ctx, command)
+
+	if aggregateAware, ok := command.(bus.AggregateAware); ok {
+		b.notifier.AfterCommit(ctx, func() {
+			b.queryBus.Invalidate(aggregateAware.AggregateID())
+		})
+	}
+
+	return err
+}
+
+// Register implements bus.CommandBus.Register method.
+func (b CacheInvalidatingCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	return b.commandBus.Register(commandType, handler)
+}
@@ -0,0 +1,200 @@
+package bus
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/cache"
+)
+
+const testQueryType bus.QueryType = "test.query"
+
+type testQuery struct {
+	aggregateID string
+}
+
+func (q testQuery) Type() bus.QueryType {
+	return testQueryType
+}
+
+func (q testQuery) AggregateID() string {
+	return q.aggregateID
+}
+
+type testAggregateCommand struct {
+	aggregateID string
+}
+
+func (c testAggregateCommand) Type() bus.CommandType {
+	return testCommandType
+}
+
+func (c testAggregateCommand) AggregateID() string {
+	return c.aggregateID
+}
+
+type countingQueryHandler struct {
+	calls int
+}
+
+func (h *countingQueryHandler) Handle(_ context.Context, q bus.Query) (any, error) {
+	h.calls++
+
+	return q.(testQuery).aggregateID, nil
+}
+
+// fakeNotifier runs functions at once unless transaction is pending, then defers them until commit.
+type fakeNotifier struct {
+	pending  bool
+	deferred []func()
+}
+
+func (n *fakeNotifier) AfterCommit(_ context.Context, fn func()) {
+	if !n.pending {
+		fn()
+
+		return
+	}
+
+	n.deferred = append(n.deferred, fn)
+}
+
+func (n *fakeNotifier) commit() {
+	for _, fn := range n.deferred {
+		fn()
+	}
+}
+
+func newTestCachingQueryBus(t *testing.T) (*CachingQueryBus, *countingQueryHandler) {
+	handler := new(countingQueryHandler)
+
+	cachingQueryBus := NewCachingQueryBus(
+		NewQueryBus(),
+		cache.NewLRU(10),
+		map[bus.QueryType]time.Duration{testQueryType: time.Minute},
+	)
+	require.NoError(t, cachingQueryBus.Register(testQueryType, handler))
+
+	return cachingQueryBus, handler
+}
+
+func TestCachingQueryBusHitAndMiss(t *testing.T) {
+	// assign
+	cachingQueryBus, handler := newTestCachingQueryBus(t)
+
+	// act
+	first, err := cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "first"})
+	require.NoError(t, err)
+	cached, err := cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "first"})
+	require.NoError(t, err)
+	second, err := cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "second"})
+	require.NoError(t, err)
+
+	// assert
+	assert.Equal(t, "first", first)
+	assert.Equal(t, "first", cached)
+	assert.Equal(t, "second", second)
+	assert.Equal(t, 2, handler.calls)
+	assert.Equal(t, QueryCacheStats{Hits: 1, Misses: 2, Size: 2}, cachingQueryBus.Stats())
+}
+
+func TestCachingQueryBusInvalidatedByCommand(t *testing.T) {
+	// assign
+	cachingQueryBus, handler := newTestCachingQueryBus(t)
+
+	commandBus := NewCacheInvalidatingCommandBus(NewInMemoryCommandBus(), cachingQueryBus, new(fakeNotifier))
+	require.NoError(t, commandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	_, _ = cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "first"})
+	_, _ = cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "second"})
+	err := commandBus.Dispatch(context.Background(), testAggregateCommand{aggregateID: "first"})
+	require.NoError(t, err)
+	_, _ = cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "first"})
+	_, _ = cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "second"})
+
+	// assert
+	assert.Equal(t, 3, handler.calls)
+	assert.Equal(t, QueryCacheStats{Hits: 1, Misses: 3, Size: 2}, cachingQueryBus.Stats())
+}
+
+func TestCachingQueryBusInvalidatedAfterOuterTransactionCommit(t *testing.T) {
+	// assign
+	cachingQueryBus, handler := newTestCachingQueryBus(t)
+	notifier := &fakeNotifier{pending: true}
+
+	commandBus := NewCacheInvalidatingCommandBus(NewInMemoryCommandBus(), cachingQueryBus, notifier)
+	require.NoError(t, commandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	err := commandBus.Dispatch(context.Background(), testAggregateCommand{aggregateID: "first"})
+	require.NoError(t, err)
+	// read made before commit caches the old state
+	_, _ = cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "first"})
+	notifier.commit()
+	_, _ = cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "first"})
+
+	// assert
+	assert.Equal(t, 2, handler.calls)
+	assert.Equal(t, QueryCacheStats{Hits: 0, Misses: 2, Size: 1}, cachingQueryBus.Stats())
+}
+
+func TestCachingQueryBusInvalidatesUUIDInAnyLetterCase(t *testing.T) {
+	// assign
+	cachingQueryBus, handler := newTestCachingQueryBus(t)
+
+	commandBus := NewCacheInvalidatingCommandBus(NewInMemoryCommandBus(), cachingQueryBus, new(fakeNotifier))
+	require.NoError(t, commandBus.Register(testCommandType, testCommandHandler{}))
+
+	aggregateID := "6f1c3a2e-8b4d-4e6f-9a1b-2c3d4e5f6a7b"
+
+	// act
+	_, _ = cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: strings.ToUpper(aggregateID)})
+	err := commandBus.Dispatch(context.Background(), testAggregateCommand{aggregateID: aggregateID})
+	require.NoError(t, err)
+	_, _ = cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: strings.ToUpper(aggregateID)})
+
+	// assert
+	assert.Equal(t, 2, handler.calls)
+}
+
+type testResult struct {
+	value string
+}
+
+type pointerQueryHandler struct{}
+
+func (h pointerQueryHandler) Handle(context.Context, bus.Query) (any, error) {
+	return &testResult{value: "cached"}, nil
+}
+
+func TestCachingQueryBusDoesNotShareCachedResult(t *testing.T) {
+	// assign
+	cachingQueryBus := NewCachingQueryBus(
+		NewQueryBus(),
+		cache.NewLRU(10),
+		map[bus.QueryType]time.Duration{testQueryType: time.Minute},
+	)
+	require.NoError(t, cachingQueryBus.Register(testQueryType, pointerQueryHandler{}))
+
+	// act
+	first, err := cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "1"})
+	require.NoError(t, err)
+	first.(*testResult).value = "changed by caller"
+
+	second, err := cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "1"})
+	require.NoError(t, err)
+	second.(*testResult).value = "changed by another caller"
+
+	third, err := cachingQueryBus.Ask(context.Background(), testQuery{aggregateID: "1"})
+	require.NoError(t, err)
+
+	// assert
+	assert.Equal(t, "cached", third.(*testResult).value)
+	assert.Equal(t, uint64(2), cachingQueryBus.Stats().Hits)
+}
@@ -3,33 +3,68 @@ package bus
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
 )
 
 // InMemoryCommandBus represents in memory command bus implementation of bus.CommandBus interface.
+// Handlers may be registered and dispatched concurrently.
 type InMemoryCommandBus struct {
+	mu       sync.RWMutex
 	handlers map[bus.CommandType]bus.CommandHandler
 }
 
 // NewInMemoryCommandBus creates a new InMemoryCommandBus.
-func NewInMemoryCommandBus() InMemoryCommandBus {
-	return InMemoryCommandBus{
+func NewInMemoryCommandBus() *InMemoryCommandBus {
+	return &InMemoryCommandBus{
 		handlers: make(map[bus.CommandType]bus.CommandHandler),
 	}
 }
 
-// Dispatch implements bus.CommandBus.Dispatch method.
-func (b InMemoryCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+// Dispatch implements bus.CommandBus.Dispatch method. Command is validated before handler is invoked.
+func (b *InMemoryCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	b.mu.RLock()
 	handler, ok := b.handlers[command.Type()]
+	b.mu.RUnlock()
+
 	if !ok {
 		return fmt.Errorf("%s: %w", command.Type(), bus.ErrCommandHandlerNotFound)
 	}
 
+	if err := bus.Validate(command); err != nil {
+		return err
+	}
+
 	return handler.Handle(ctx, command)
 }
 
 // Register implements bus.CommandBus.Register method.
-func (b InMemoryCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) {
+func (b *InMemoryCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if registered, ok := b.handlers[commandType]; ok {
+		return fmt.Errorf("command type %s by %s: %w", commandType, bus.HandlerName(registered), bus.ErrHandlerAlreadyRegistered)
+	}
+
 	b.handlers[commandType] = handler
+
+	return nil
+}
+
+// Handlers implements bus.HandlerRegistry interface.
+func (b *InMemoryCommandBus) Handlers() []bus.HandlerInfo {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	handlers := make([]bus.HandlerInfo, 0, len(b.handlers))
+
+	for commandType, handler := range b.handlers {
+		handlers = append(handlers, bus.HandlerInfo{Type: string(commandType), Handler: bus.HandlerName(handler)})
+	}
+
+	bus.SortHandlers(handlers)
+
+	return handlers
 }
@@ -0,0 +1,99 @@
+package bus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+type invalidTestCommand struct {
+	testCommand
+}
+
+func (c invalidTestCommand) Validate() error {
+	validationError := bus.ValidationError{}
+	validationError.Add("field", bus.ErrFieldRequired)
+
+	return validationError.ErrorOrNil()
+}
+
+type recordingCommandHandler struct {
+	handled bool
+}
+
+func (h *recordingCommandHandler) Handle(_ context.Context, _ bus.Command) error {
+	h.handled = true
+
+	return nil
+}
+
+func TestInMemoryCommandBusHandlerNotFoundError(t *testing.T) {
+	// act
+	err := NewInMemoryCommandBus().Dispatch(context.Background(), testCommand{})
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrCommandHandlerNotFound)
+}
+
+func TestInMemoryCommandBusValidationError(t *testing.T) {
+	// assign
+	handler := new(recordingCommandHandler)
+
+	commandBus := NewInMemoryCommandBus()
+	require.NoError(t, commandBus.Register(testCommandType, handler))
+
+	// act
+	err := commandBus.Dispatch(context.Background(), invalidTestCommand{})
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+	assert.False(t, handler.handled)
+}
+
+func TestAsyncCommandBusValidationError(t *testing.T) {
+	// assign
+	handler := new(recordingCommandHandler)
+	jobRepository := newInMemoryJobRepository()
+
+	asyncCommandBus := NewAsyncCommandBus(NewInMemoryCommandBus(), jobRepository, 1, 1)
+	require.NoError(t, asyncCommandBus.Register(testCommandType, handler))
+
+	// act
+	jobID, err := asyncCommandBus.Enqueue(context.Background(), invalidTestCommand{})
+	_ = asyncCommandBus.Shutdown(context.Background())
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+	assert.Empty(t, jobID)
+	assert.Empty(t, jobRepository.jobs)
+	assert.False(t, handler.handled)
+}
+
+func TestInMemoryCommandBusDuplicateRegistrationError(t *testing.T) {
+	// assign
+	commandBus := NewInMemoryCommandBus()
+	require.NoError(t, commandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	err := commandBus.Register(testCommandType, new(recordingCommandHandler))
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrHandlerAlreadyRegistered)
+	assert.Equal(t, []bus.HandlerInfo{{Type: string(testCommandType), Handler: "bus.testCommandHandler"}}, commandBus.Handlers())
+}
+
+func TestQueryBusDuplicateRegistrationError(t *testing.T) {
+	// assign
+	queryBus := NewQueryBus()
+	require.NoError(t, queryBus.Register(testQueryType, new(countingQueryHandler)))
+
+	// act
+	err := queryBus.Register(testQueryType, new(countingQueryHandler))
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrHandlerAlreadyRegistered)
+	assert.Equal(t, []bus.HandlerInfo{{Type: string(testQueryType), Handler: "*bus.countingQueryHandler"}}, queryBus.Handlers())
+}
@@ -0,0 +1,44 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/projection"
+)
+
+// ProjectingCommandBus represents bus.CommandBus decorator updating read models after successfully handled command.
+// It must be wrapped by TransactionalCommandBus, so projection failure rolls back the command changes as well.
+type ProjectingCommandBus struct {
+	commandBus  bus.CommandBus
+	projections []projection.Projection
+}
+
+// NewProjectingCommandBus creates a new ProjectingCommandBus.
+func NewProjectingCommandBus(commandBus bus.CommandBus, projections ...projection.Projection) ProjectingCommandBus {
+	return ProjectingCommandBus{
+		commandBus:  commandBus,
+		projections: projections,
+	}
+}
+
+// Dispatch implements bus.CommandBus.Dispatch method.
+func (b ProjectingCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	if err := b.commandBus.Dispatch(ctx, command); err != nil {
+		return err
+	}
+
+	for _, p := range b.projections {
+		if err := p.Project(ctx, command); err != nil {
+			return fmt.Errorf("command %s projection: %w", command.Type(), err)
+		}
+	}
+
+	return nil
+}
+
+// Register implements bus.CommandBus.Register method.
+func (b ProjectingCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	return b.commandBus.Register(commandType, handler)
+}
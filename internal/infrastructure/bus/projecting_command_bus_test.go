@@ -0,0 +1,67 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+var errTestProjectionFailed = errors.New("test projection failed")
+
+type recordingProjection struct {
+	err       error
+	projected []bus.Command
+}
+
+func (p *recordingProjection) Project(_ context.Context, command bus.Command) error {
+	p.projected = append(p.projected, command)
+
+	return p.err
+}
+
+func TestProjectingCommandBusProjectsHandledCommand(t *testing.T) {
+	// assign
+	projection := new(recordingProjection)
+	projectingCommandBus := NewProjectingCommandBus(NewInMemoryCommandBus(), projection)
+	require.NoError(t, projectingCommandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	err := projectingCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, []bus.Command{testCommand{}}, projection.projected)
+}
+
+func TestProjectingCommandBusSkipsFailedCommand(t *testing.T) {
+	// assign
+	projection := new(recordingProjection)
+	projectingCommandBus := NewProjectingCommandBus(NewInMemoryCommandBus(), projection)
+	require.NoError(t, projectingCommandBus.Register(testCommandType, testCommandHandler{err: errTestHandlerFailed}))
+
+	// act
+	err := projectingCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	assert.ErrorIs(t, err, errTestHandlerFailed)
+	assert.Empty(t, projection.projected)
+}
+
+func TestProjectingCommandBusProjectionErrorRollsBackCommand(t *testing.T) {
+	// assign
+	unitOfWork := new(fakeUnitOfWork)
+	projectingCommandBus := NewProjectingCommandBus(NewInMemoryCommandBus(), &recordingProjection{err: errTestProjectionFailed})
+	transactionalCommandBus := NewTransactionalCommandBus(projectingCommandBus, unitOfWork)
+	require.NoError(t, transactionalCommandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	err := transactionalCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	assert.ErrorIs(t, err, errTestProjectionFailed)
+	assert.True(t, unitOfWork.rolledBack)
+}
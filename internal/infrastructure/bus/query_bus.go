@@ -3,12 +3,15 @@ package bus
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
 )
 
 // QueryBus represents in memory query bus implementation of bus.QueryBus interface.
+// Handlers may be registered and asked concurrently.
 type QueryBus struct {
+	mu       sync.RWMutex
 	handlers map[bus.QueryType]bus.QueryHandler
 }
 
@@ -19,17 +22,49 @@ func NewQueryBus() *QueryBus {
 	}
 }
 
-// Ask implements bus.CommandBus.Ask method.
-func (b QueryBus) Ask(ctx context.Context, query bus.Query) (any, error) {
+// Ask implements bus.QueryBus.Ask method. Query is validated before handler is invoked.
+func (b *QueryBus) Ask(ctx context.Context, query bus.Query) (any, error) {
+	b.mu.RLock()
 	handler, ok := b.handlers[query.Type()]
+	b.mu.RUnlock()
+
 	if !ok {
 		return nil, fmt.Errorf("query type %s: %w", query.Type(), bus.ErrQueryHandlerNotFound)
 	}
 
+	if err := bus.Validate(query); err != nil {
+		return nil, err
+	}
+
 	return handler.Handle(ctx, query)
 }
 
-// Register implements bus.CommandBus.Register method.
-func (b QueryBus) Register(queryType bus.QueryType, handler bus.QueryHandler) {
+// Register implements bus.QueryBus.Register method.
+func (b *QueryBus) Register(queryType bus.QueryType, handler bus.QueryHandler) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if registered, ok := b.handlers[queryType]; ok {
+		return fmt.Errorf("query type %s by %s: %w", queryType, bus.HandlerName(registered), bus.ErrHandlerAlreadyRegistered)
+	}
+
 	b.handlers[queryType] = handler
+
+	return nil
+}
+
+// Handlers implements bus.HandlerRegistry interface.
+func (b *QueryBus) Handlers() []bus.HandlerInfo {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	handlers := make([]bus.HandlerInfo, 0, len(b.handlers))
+
+	for queryType, handler := range b.handlers {
+		handlers = append(handlers, bus.HandlerInfo{Type: string(queryType), Handler: bus.HandlerName(handler)})
+	}
+
+	bus.SortHandlers(handlers)
+
+	return handlers
 }
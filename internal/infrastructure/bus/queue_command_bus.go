@@ -0,0 +1,252 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+// QueueOptions configures QueueCommandBus workers.
+type QueueOptions struct {
+	Workers           uint
+	PollInterval      time.Duration
+	VisibilityTimeout time.Duration
+	MaxDeliveries     uint
+}
+
+// QueueCommandBus represents durable implementation of bus.AsyncCommandBus interface.
+// Commands are encoded with bus.CommandCodec and stored in bus.CommandQueue, so they survive process restarts.
+// Workers of every process claim queued commands, handle them by wrapped bus.CommandBus and store job status
+// in bus.JobRepository. Workers wait for wakeups, e.g. database notifications, and poll queue as a fallback.
+type QueueCommandBus struct {
+	commandBus    bus.CommandBus
+	queue         bus.CommandQueue
+	codec         *bus.CommandCodec
+	jobRepository bus.JobRepository
+	wakeups       <-chan struct{}
+	options       QueueOptions
+	stop          chan struct{}
+	stopOnce      sync.Once
+	wg            sync.WaitGroup
+}
+
+// NewQueueCommandBus creates a new QueueCommandBus. Workers are started with Start.
+func NewQueueCommandBus(
+	commandBus bus.CommandBus,
+	queue bus.CommandQueue,
+	codec *bus.CommandCodec,
+	jobRepository bus.JobRepository,
+	wakeups <-chan struct{},
+	options QueueOptions,
+) *QueueCommandBus {
+	return &QueueCommandBus{
+		commandBus:    commandBus,
+		queue:         queue,
+		codec:         codec,
+		jobRepository: jobRepository,
+		wakeups:       wakeups,
+		options:       options,
+		stop:          make(chan struct{}),
+	}
+}
+
+// Dispatch implements bus.CommandBus.Dispatch method. Command is enqueued and handled by workers.
+func (b *QueueCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	_, err := b.Enqueue(ctx, command)
+
+	return err
+}
+
+// Register implements bus.CommandBus.Register method.
+func (b *QueueCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	return b.commandBus.Register(commandType, handler)
+}
+
+// Enqueue implements bus.AsyncCommandBus.Enqueue method. Queued command shares identifier with its job.
+// Command is validated and encoded before job is created, so invalid commands are rejected synchronously.
+func (b *QueueCommandBus) Enqueue(ctx context.Context, command bus.Command) (string, error) {
+	if err := bus.Validate(command); err != nil {
+		return "", err
+	}
+
+	payload, err := b.codec.Encode(command)
+	if err != nil {
+		return "", err
+	}
+
+	job := bus.NewJob(uuid.New().String(), command.Type())
+
+	if err := b.jobRepository.Add(ctx, job); err != nil {
+		return "", err
+	}
+
+	if err := b.queue.Enqueue(ctx, bus.NewQueuedCommand(job.ID, command, payload)); err != nil {
+		job.Fail(err)
+		b.updateJob(ctx, job)
+
+		return "", err
+	}
+
+	return job.ID, nil
+}
+
+// Start starts workers consuming queued commands until Shutdown.
+func (b *QueueCommandBus) Start() {
+	for i := uint(0); i < b.options.Workers; i++ {
+		b.wg.Add(1)
+
+		go b.work()
+	}
+}
+
+// Shutdown stops workers and waits until commands they are handling are finished.
+// Queued commands are kept in queue for the next start.
+func (b *QueueCommandBus) Shutdown(ctx context.Context) error {
+	b.stopOnce.Do(func() { close(b.stop) })
+
+	done := make(chan struct{})
+
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// work handles queued commands until bus is stopped. Worker sleeps only when queue has no available command.
+func (b *QueueCommandBus) work() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stop:
+			return
+		default:
+		}
+
+		handled, err := b.handleNext()
+		if err != nil {
+			log.Printf("command queue claim failed: %s", err)
+		}
+
+		if handled {
+			continue
+		}
+
+		select {
+		case <-b.stop:
+			return
+		case <-b.wakeups:
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleNext claims next queued command, dispatches it to wrapped bus.CommandBus and stores job result.
+// Command is acknowledged even if it fails, failure is reported by its job. Command lease is extended
+// while it is dispatched, so it is not claimed by another worker when handling outlives visibility timeout.
+func (b *QueueCommandBus) handleNext() (bool, error) {
+	ctx := context.Background()
+
+	queued, ok, err := b.queue.Claim(ctx, time.Now(), b.options.VisibilityTimeout)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	job := &bus.Job{ID: queued.ID, CommandType: queued.CommandType, CreatedAt: queued.CreatedAt}
+	job.Start()
+	b.updateJob(ctx, job)
+
+	leaseCtx, stopLease := context.WithCancel(ctx)
+	leaseDone := make(chan struct{})
+
+	go func() {
+		defer close(leaseDone)
+		b.extendLease(leaseCtx, queued)
+	}()
+
+	command, err := b.dispatch(ctx, queued)
+
+	stopLease()
+	<-leaseDone
+
+	if err != nil {
+		job.Fail(err)
+	} else {
+		job.Succeed(command)
+	}
+
+	b.updateJob(ctx, job)
+
+	if err := b.queue.Ack(ctx, queued); err != nil {
+		log.Printf("queued command %s acknowledgement failed: %s", queued.ID, err)
+	}
+
+	return true, nil
+}
+
+// extendLease extends queued command lease every half of visibility timeout until ctx is done.
+// It gives up once lease is lost, because command is handled by another worker since.
+func (b *QueueCommandBus) extendLease(ctx context.Context, queued *bus.QueuedCommand) {
+	if b.options.VisibilityTimeout <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(b.options.VisibilityTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			err := b.queue.Extend(ctx, queued, now.Add(b.options.VisibilityTimeout))
+			if err == nil || ctx.Err() != nil {
+				continue
+			}
+
+			log.Printf("queued command %s lease extension failed: %s", queued.ID, err)
+
+			if errors.Is(err, bus.ErrCommandLeaseLost) {
+				return
+			}
+		}
+	}
+}
+
+// dispatch decodes queued command and dispatches it unless it was already delivered too many times,
+// e.g. because it crashes every worker picking it up. Dispatched command is returned to store job result.
+func (b *QueueCommandBus) dispatch(ctx context.Context, queued *bus.QueuedCommand) (bus.Command, error) {
+	if b.options.MaxDeliveries > 0 && queued.Deliveries > b.options.MaxDeliveries {
+		return nil, fmt.Errorf("%s %s: %w", queued.CommandType, queued.ID, bus.ErrCommandDeliveriesExhausted)
+	}
+
+	command, err := b.codec.Decode(queued.CommandType, queued.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return command, b.commandBus.Dispatch(ctx, command)
+}
+
+// updateJob persists job status. Failure is only logged because there is nobody to return it to.
+func (b *QueueCommandBus) updateJob(ctx context.Context, job *bus.Job) {
+	if err := b.jobRepository.Update(ctx, job); err != nil {
+		log.Printf("job %s status %s update failed: %s", job.ID, job.Status, err)
+	}
+}
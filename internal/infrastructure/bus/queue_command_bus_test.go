@@ -0,0 +1,226 @@
+package bus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+type queuedTestCommand struct {
+	command     *bus.QueuedCommand
+	lockedUntil time.Time
+}
+
+// inMemoryCommandQueue is bus.CommandQueue keeping the same ordering and visibility rules as the database one.
+type inMemoryCommandQueue struct {
+	mu       sync.Mutex
+	commands []*queuedTestCommand
+}
+
+func (q *inMemoryCommandQueue) Enqueue(_ context.Context, command *bus.QueuedCommand) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.commands = append(q.commands, &queuedTestCommand{command: command})
+
+	return nil
+}
+
+func (q *inMemoryCommandQueue) Claim(
+	_ context.Context,
+	now time.Time,
+	visibilityTimeout time.Duration,
+) (*bus.QueuedCommand, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	blocked := make(map[string]bool)
+
+	for _, queued := range q.commands {
+		key := queued.command.OrderingKey
+		if key != "" && blocked[key] {
+			continue
+		}
+
+		blocked[key] = true
+
+		if queued.lockedUntil.After(now) {
+			continue
+		}
+
+		queued.lockedUntil = now.Add(visibilityTimeout)
+		queued.command.Deliveries++
+		claimed := *queued.command
+
+		return &claimed, true, nil
+	}
+
+	return nil, false, nil
+}
+
+func (q *inMemoryCommandQueue) Extend(_ context.Context, command *bus.QueuedCommand, lockedUntil time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, queued := range q.commands {
+		if queued.command.ID == command.ID && queued.command.Deliveries == command.Deliveries {
+			queued.lockedUntil = lockedUntil
+
+			return nil
+		}
+	}
+
+	return bus.ErrCommandLeaseLost
+}
+
+func (q *inMemoryCommandQueue) Ack(_ context.Context, command *bus.QueuedCommand) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, queued := range q.commands {
+		if queued.command.ID == command.ID && queued.command.Deliveries == command.Deliveries {
+			q.commands = append(q.commands[:i], q.commands[i+1:]...)
+
+			return nil
+		}
+	}
+
+	return bus.ErrCommandLeaseLost
+}
+
+func (q *inMemoryCommandQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.commands)
+}
+
+func newTestQueueCommandBus(
+	t *testing.T,
+	queue bus.CommandQueue,
+	jobRepository bus.JobRepository,
+	handler bus.CommandHandler,
+) *QueueCommandBus {
+	codec := bus.NewCommandCodec()
+	bus.RegisterCommandCodec[testCommand](codec)
+
+	queueCommandBus := NewQueueCommandBus(
+		NewInMemoryCommandBus(),
+		queue,
+		codec,
+		jobRepository,
+		make(chan struct{}),
+		QueueOptions{Workers: 2, PollInterval: time.Millisecond, VisibilityTimeout: time.Minute, MaxDeliveries: 3},
+	)
+	require.NoError(t, queueCommandBus.Register(testCommandType, handler))
+
+	return queueCommandBus
+}
+
+func TestQueueCommandBusHandleSuccess(t *testing.T) {
+	// assign
+	queue := new(inMemoryCommandQueue)
+	jobRepository := newInMemoryJobRepository()
+	queueCommandBus := newTestQueueCommandBus(t, queue, jobRepository, testCommandHandler{})
+
+	// act
+	jobID, err := queueCommandBus.Enqueue(context.Background(), testCommand{UUID: "1"})
+	require.NoError(t, err)
+
+	queueCommandBus.Start()
+	require.Eventually(t, func() bool { return queue.len() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, queueCommandBus.Shutdown(context.Background()))
+
+	// assert
+	job, _ := jobRepository.GetByID(context.Background(), jobID)
+	assert.Equal(t, bus.JobSucceeded, job.Status)
+	assert.Equal(t, testCommandType, job.CommandType)
+	assert.Equal(t, "1", job.Result)
+}
+
+func TestQueueCommandBusHandleError(t *testing.T) {
+	// assign
+	queue := new(inMemoryCommandQueue)
+	jobRepository := newInMemoryJobRepository()
+	queueCommandBus := newTestQueueCommandBus(t, queue, jobRepository, testCommandHandler{err: errTestHandlerFailed})
+
+	// act
+	jobID, err := queueCommandBus.Enqueue(context.Background(), testCommand{})
+	require.NoError(t, err)
+
+	queueCommandBus.Start()
+	require.Eventually(t, func() bool { return queue.len() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, queueCommandBus.Shutdown(context.Background()))
+
+	// assert
+	job, _ := jobRepository.GetByID(context.Background(), jobID)
+	assert.Equal(t, bus.JobFailed, job.Status)
+	assert.Equal(t, errTestHandlerFailed.Error(), job.Error)
+}
+
+func TestQueueCommandBusExtendsLeaseOfLongRunningCommand(t *testing.T) {
+	// assign
+	handler := testCommandHandler{started: make(chan struct{}, 2), release: make(chan struct{})}
+	queue := new(inMemoryCommandQueue)
+	jobRepository := newInMemoryJobRepository()
+	queueCommandBus := newTestQueueCommandBus(t, queue, jobRepository, handler)
+	queueCommandBus.options.VisibilityTimeout = 20 * time.Millisecond
+
+	jobID, err := queueCommandBus.Enqueue(context.Background(), testCommand{UUID: "1"})
+	require.NoError(t, err)
+
+	// act
+	queueCommandBus.Start()
+	<-handler.started
+	time.Sleep(10 * queueCommandBus.options.VisibilityTimeout)
+	close(handler.release)
+	require.Eventually(t, func() bool { return queue.len() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, queueCommandBus.Shutdown(context.Background()))
+
+	// assert
+	assert.Empty(t, handler.started, "command outliving visibility timeout was claimed again")
+
+	job, _ := jobRepository.GetByID(context.Background(), jobID)
+	assert.Equal(t, bus.JobSucceeded, job.Status)
+}
+
+func TestQueueCommandBusDeliveriesExhausted(t *testing.T) {
+	// assign
+	handler := new(recordingCommandHandler)
+	queue := new(inMemoryCommandQueue)
+	jobRepository := newInMemoryJobRepository()
+	queueCommandBus := newTestQueueCommandBus(t, queue, jobRepository, handler)
+
+	queued := bus.NewQueuedCommand("1", testCommand{}, []byte(`{}`))
+	queued.Deliveries = 3
+	require.NoError(t, queue.Enqueue(context.Background(), queued))
+
+	// act
+	queueCommandBus.Start()
+	require.Eventually(t, func() bool { return queue.len() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, queueCommandBus.Shutdown(context.Background()))
+
+	// assert
+	job, _ := jobRepository.GetByID(context.Background(), "1")
+	assert.Equal(t, bus.JobFailed, job.Status)
+	assert.Contains(t, job.Error, bus.ErrCommandDeliveriesExhausted.Error())
+	assert.False(t, handler.handled)
+}
+
+func TestQueueCommandBusValidationError(t *testing.T) {
+	// assign
+	queue := new(inMemoryCommandQueue)
+	queueCommandBus := newTestQueueCommandBus(t, queue, newInMemoryJobRepository(), new(recordingCommandHandler))
+
+	// act
+	_, err := queueCommandBus.Enqueue(context.Background(), invalidTestCommand{})
+
+	// assert
+	assert.ErrorIs(t, err, bus.ErrValidationFailed)
+	assert.Equal(t, 0, queue.len())
+}
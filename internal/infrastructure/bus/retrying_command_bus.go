@@ -0,0 +1,101 @@
+package bus
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/retry"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/transaction"
+)
+
+// RetryingCommandBus represents bus.CommandBus decorator retrying commands failed with retryable errors
+// according to retry.Policy of command type. Command which exhausted its attempts is stored as deadletter.Letter,
+// so it can be inspected and replayed later. Wrapped bus must start its own transaction for every attempt.
+type RetryingCommandBus struct {
+	commandBus  bus.CommandBus
+	policies    retry.Policies
+	detector    transaction.Detector
+	deadLetters deadletter.Repository
+	codec       *bus.CommandCodec
+	random      func() float64
+}
+
+// NewRetryingCommandBus creates a new RetryingCommandBus.
+func NewRetryingCommandBus(
+	commandBus bus.CommandBus,
+	policies retry.Policies,
+	detector transaction.Detector,
+	deadLetters deadletter.Repository,
+	codec *bus.CommandCodec,
+) RetryingCommandBus {
+	return RetryingCommandBus{
+		commandBus:  commandBus,
+		policies:    policies,
+		detector:    detector,
+		deadLetters: deadLetters,
+		codec:       codec,
+		random:      rand.Float64,
+	}
+}
+
+// Dispatch implements bus.CommandBus.Dispatch method.
+// Commands dispatched inside already started transaction are neither retried nor dead-lettered,
+// because the failed transaction can only be rolled back by its owner.
+func (b RetryingCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	if b.detector.InTransaction(ctx) {
+		return b.commandBus.Dispatch(ctx, command)
+	}
+
+	policy := b.policies.For(command.Type())
+
+	for attempt := uint(1); ; attempt++ {
+		err := b.commandBus.Dispatch(ctx, command)
+		if err == nil || !policy.IsRetryable(err) {
+			return err
+		}
+
+		if attempt >= policy.MaxAttempts {
+			b.deadLetter(command, err, attempt)
+
+			return err
+		}
+
+		timer := time.NewTimer(policy.Backoff(attempt, b.random()))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			b.deadLetter(command, err, attempt)
+
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// Register implements bus.CommandBus.Register method.
+func (b RetryingCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	return b.commandBus.Register(commandType, handler)
+}
+
+// deadLetter stores command failed with retryable err. Failure is only logged to keep command error returned to caller.
+// Letter is stored with background context, so it is kept even if dispatch was cancelled.
+func (b RetryingCommandBus) deadLetter(command bus.Command, err error, attempts uint) {
+	payload, encodeErr := b.codec.Encode(command)
+	if encodeErr != nil {
+		log.Printf("command %s cannot be dead-lettered: %s", command.Type(), encodeErr)
+
+		return
+	}
+
+	letter := deadletter.NewLetter(uuid.New().String(), command.Type(), payload, err, attempts)
+
+	if addErr := b.deadLetters.Add(context.Background(), letter); addErr != nil {
+		log.Printf("command %s dead letter persist failed: %s", command.Type(), addErr)
+	}
+}
@@ -0,0 +1,146 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/retry"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+var errTestTransient = errors.New("test transient failure")
+
+// flakyCommandHandler fails with err until it is called failures times.
+type flakyCommandHandler struct {
+	err      error
+	failures int
+	calls    int
+}
+
+func (h *flakyCommandHandler) Handle(_ context.Context, _ bus.Command) error {
+	h.calls++
+
+	if h.calls <= h.failures {
+		return h.err
+	}
+
+	return nil
+}
+
+type fakeDetector struct {
+	inTransaction bool
+}
+
+func (d fakeDetector) InTransaction(context.Context) bool {
+	return d.inTransaction
+}
+
+func newTestRetryingCommandBus(
+	t *testing.T,
+	handler bus.CommandHandler,
+	detector fakeDetector,
+	deadLetters deadletter.Repository,
+) RetryingCommandBus {
+	codec := bus.NewCommandCodec()
+	bus.RegisterCommandCodec[testCommand](codec)
+
+	policy := retry.Policy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Multiplier:     2,
+		Retryable: func(err error) bool {
+			return errors.Is(err, errTestTransient)
+		},
+	}
+
+	retryingCommandBus := NewRetryingCommandBus(
+		NewInMemoryCommandBus(),
+		retry.NewPolicies(policy, nil),
+		detector,
+		deadLetters,
+		codec,
+	)
+	require.NoError(t, retryingCommandBus.Register(testCommandType, handler))
+
+	return retryingCommandBus
+}
+
+func TestRetryingCommandBusRetriesTransientError(t *testing.T) {
+	// assign
+	handler := &flakyCommandHandler{err: errTestTransient, failures: 2}
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+
+	retryingCommandBus := newTestRetryingCommandBus(t, handler, fakeDetector{}, deadLetterRepositoryMock)
+
+	// act
+	err := retryingCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, handler.calls)
+}
+
+func TestRetryingCommandBusDeadLettersExhaustedCommand(t *testing.T) {
+	// assign
+	handler := &flakyCommandHandler{err: errTestTransient, failures: 5}
+
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+	deadLetterRepositoryMock.On("Add", mock.Anything, mock.MatchedBy(func(letter *deadletter.Letter) bool {
+		return letter.CommandType == testCommandType && letter.Attempts == 3 && letter.Error == errTestTransient.Error()
+	})).Return(nil)
+
+	retryingCommandBus := newTestRetryingCommandBus(t, handler, fakeDetector{}, deadLetterRepositoryMock)
+
+	// act
+	err := retryingCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, errTestTransient)
+	assert.Equal(t, 3, handler.calls)
+}
+
+func TestRetryingCommandBusDoesNotRetryDomainError(t *testing.T) {
+	// assign
+	handler := &flakyCommandHandler{err: errTestHandlerFailed, failures: 1}
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+
+	retryingCommandBus := newTestRetryingCommandBus(t, handler, fakeDetector{}, deadLetterRepositoryMock)
+
+	// act
+	err := retryingCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, errTestHandlerFailed)
+	assert.Equal(t, 1, handler.calls)
+}
+
+func TestRetryingCommandBusDoesNotRetryInsideTransaction(t *testing.T) {
+	// assign
+	handler := &flakyCommandHandler{err: errTestTransient, failures: 1}
+	deadLetterRepositoryMock := new(persistence.DeadLetterRepository)
+
+	retryingCommandBus := newTestRetryingCommandBus(
+		t,
+		handler,
+		fakeDetector{inTransaction: true},
+		deadLetterRepositoryMock,
+	)
+
+	// act
+	err := retryingCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	deadLetterRepositoryMock.AssertExpectations(t)
+	assert.ErrorIs(t, err, errTestTransient)
+	assert.Equal(t, 1, handler.calls)
+}
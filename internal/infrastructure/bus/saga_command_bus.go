@@ -0,0 +1,124 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/saga"
+)
+
+// TransactionalSagaCommandBus represents bus.CommandBus decorator passing successful command outcomes to saga.Manager.
+// It must be wrapped by TransactionalCommandBus, so saga state and follow-up commands are committed together
+// with command and saga failure rolls command back. Follow-up commands are dispatched through itself
+// in the same transaction.
+type TransactionalSagaCommandBus struct {
+	commandBus bus.CommandBus
+	manager    *saga.Manager
+}
+
+// NewTransactionalSagaCommandBus creates a new TransactionalSagaCommandBus.
+func NewTransactionalSagaCommandBus(commandBus bus.CommandBus, manager *saga.Manager) TransactionalSagaCommandBus {
+	return TransactionalSagaCommandBus{
+		commandBus: commandBus,
+		manager:    manager,
+	}
+}
+
+// Dispatch implements bus.CommandBus.Dispatch method.
+// Failed command is rolled back and possibly retried, so its outcome is passed to sagas by SagaCommandBus.
+func (b TransactionalSagaCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	if err := b.commandBus.Dispatch(ctx, command); err != nil {
+		return err
+	}
+
+	if err := b.manager.Handle(ctx, b, command, nil); err != nil {
+		return fmt.Errorf("command %s saga handling failed: %w", command.Type(), err)
+	}
+
+	return nil
+}
+
+// Register implements bus.CommandBus.Register method.
+func (b TransactionalSagaCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	return b.commandBus.Register(commandType, handler)
+}
+
+// SagaCommandBus represents the outermost bus.CommandBus decorator passing failures of commands to saga.Manager
+// once command is finally failed, i.e. rolled back and retried. Successful outcomes are passed
+// by TransactionalSagaCommandBus inside command transaction. It periodically handles expired saga timeouts
+// in background. Follow-up commands are dispatched through itself.
+type SagaCommandBus struct {
+	commandBus bus.CommandBus
+	manager    *saga.Manager
+	stop       chan struct{}
+	done       chan struct{}
+	stopOnce   sync.Once
+}
+
+// NewSagaCommandBus creates a new SagaCommandBus and starts checking saga timeouts every timeoutInterval.
+func NewSagaCommandBus(commandBus bus.CommandBus, manager *saga.Manager, timeoutInterval time.Duration) *SagaCommandBus {
+	b := &SagaCommandBus{
+		commandBus: commandBus,
+		manager:    manager,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+
+	go b.watchTimeouts(timeoutInterval)
+
+	return b
+}
+
+// Dispatch implements bus.CommandBus.Dispatch method. Saga failure is returned together with command error.
+func (b *SagaCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	err := b.commandBus.Dispatch(ctx, command)
+	if err == nil {
+		return nil
+	}
+
+	if sagaErr := b.manager.Handle(ctx, b, command, err); sagaErr != nil {
+		return fmt.Errorf("%w: command %s saga handling failed: %s", err, command.Type(), sagaErr)
+	}
+
+	return err
+}
+
+// Register implements bus.CommandBus.Register method.
+func (b *SagaCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	return b.commandBus.Register(commandType, handler)
+}
+
+// Shutdown stops saga timeouts handling and waits until the current round is finished.
+func (b *SagaCommandBus) Shutdown(ctx context.Context) error {
+	b.stopOnce.Do(func() { close(b.stop) })
+
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// watchTimeouts handles expired saga timeouts until Shutdown is called.
+func (b *SagaCommandBus) watchTimeouts(interval time.Duration) {
+	defer close(b.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stop:
+			return
+		case now := <-ticker.C:
+			if err := b.manager.HandleTimeouts(context.Background(), b, now); err != nil {
+				log.Printf("saga timeouts handling failed: %s", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package bus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/saga"
+)
+
+// followUpSaga is started by testAggregateCommand, times out immediately and dispatches testCommand on timeout.
+type followUpSaga struct{}
+
+func (s followUpSaga) Type() saga.Type {
+	return "follow_up.test.saga"
+}
+
+func (s followUpSaga) Starts(command bus.Command) bool {
+	_, ok := command.(testAggregateCommand)
+
+	return ok
+}
+
+func (s followUpSaga) Handle(state *saga.State, command bus.Command, err error) []bus.Command {
+	if err != nil {
+		state.Fail(err)
+
+		return nil
+	}
+
+	if _, ok := command.(testAggregateCommand); ok {
+		state.ScheduleTimeout(time.Now())
+	}
+
+	return nil
+}
+
+func (s followUpSaga) Timeout(state *saga.State) []bus.Command {
+	state.Complete()
+
+	return []bus.Command{testCommand{}}
+}
+
+type inMemorySagaRepository struct {
+	mu      sync.Mutex
+	states  map[string]saga.State
+	saveErr error
+}
+
+func (r *inMemorySagaRepository) Get(_ context.Context, _ saga.Type, aggregateID string) (*saga.State, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	state, ok := r.states[aggregateID]
+	if !ok {
+		return nil, saga.ErrStateNotFound
+	}
+
+	return &state, nil
+}
+
+func (r *inMemorySagaRepository) Save(_ context.Context, state *saga.State) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+
+	state.Version++
+	r.states[state.AggregateID] = *state
+
+	return nil
+}
+
+func (r *inMemorySagaRepository) ListDue(_ context.Context, now time.Time, _ uint) ([]*saga.State, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var states []*saga.State
+
+	for _, state := range r.states {
+		state := state
+		if state.IsRunning() && state.TimeoutAt != nil && !state.TimeoutAt.After(now) {
+			states = append(states, &state)
+		}
+	}
+
+	return states, nil
+}
+
+func (r *inMemorySagaRepository) List(context.Context, saga.Filter) ([]*saga.State, error) {
+	return nil, nil
+}
+
+func TestSagaCommandBusDispatchesFollowUpCommandOnTimeout(t *testing.T) {
+	// assign
+	handler := testCommandHandler{started: make(chan struct{}, 2)}
+	repository := &inMemorySagaRepository{states: make(map[string]saga.State)}
+
+	manager := saga.NewManager(repository, new(fakeUnitOfWork), followUpSaga{})
+	sagaCommandBus := NewSagaCommandBus(
+		NewTransactionalSagaCommandBus(NewInMemoryCommandBus(), manager),
+		manager,
+		10*time.Millisecond,
+	)
+	require.NoError(t, sagaCommandBus.Register(testCommandType, handler))
+
+	// act
+	err := sagaCommandBus.Dispatch(context.Background(), testAggregateCommand{aggregateID: "1"})
+
+	// assert
+	require.NoError(t, err)
+
+	// both the initial and the follow-up command share testCommandType handler
+	for i := 0; i < 2; i++ {
+		select {
+		case <-handler.started:
+		case <-time.After(time.Second):
+			t.Fatal("follow-up command is not dispatched")
+		}
+	}
+
+	require.NoError(t, sagaCommandBus.Shutdown(context.Background()))
+
+	state, _ := repository.Get(context.Background(), "", "1")
+	assert.Equal(t, saga.Completed, state.Status)
+}
+
+func TestTransactionalSagaCommandBusReturnsSagaError(t *testing.T) {
+	// assign
+	repository := &inMemorySagaRepository{states: make(map[string]saga.State), saveErr: errTestHandlerFailed}
+	unitOfWork := new(fakeUnitOfWork)
+
+	transactionalCommandBus := NewTransactionalCommandBus(
+		NewTransactionalSagaCommandBus(NewInMemoryCommandBus(), saga.NewManager(repository, new(fakeUnitOfWork), followUpSaga{})),
+		unitOfWork,
+	)
+	require.NoError(t, transactionalCommandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	err := transactionalCommandBus.Dispatch(context.Background(), testAggregateCommand{aggregateID: "1"})
+
+	// assert
+	assert.ErrorIs(t, err, errTestHandlerFailed)
+	assert.True(t, unitOfWork.rolledBack)
+}
+
+func TestSagaCommandBusPassesFinalCommandFailureToSaga(t *testing.T) {
+	// assign
+	repository := &inMemorySagaRepository{states: make(map[string]saga.State)}
+	require.NoError(t, repository.Save(context.Background(), saga.NewState("follow_up.test.saga", "1")))
+
+	manager := saga.NewManager(repository, new(fakeUnitOfWork), followUpSaga{})
+	sagaCommandBus := NewSagaCommandBus(
+		NewTransactionalSagaCommandBus(NewInMemoryCommandBus(), manager),
+		manager,
+		time.Hour,
+	)
+	require.NoError(t, sagaCommandBus.Register(testCommandType, testCommandHandler{err: errTestHandlerFailed}))
+
+	// act
+	err := sagaCommandBus.Dispatch(context.Background(), testAggregateCommand{aggregateID: "1"})
+
+	// assert
+	assert.ErrorIs(t, err, errTestHandlerFailed)
+	require.NoError(t, sagaCommandBus.Shutdown(context.Background()))
+
+	state, _ := repository.Get(context.Background(), "", "1")
+	assert.Equal(t, saga.Failed, state.Status)
+	assert.Equal(t, errTestHandlerFailed.Error(), state.Error)
+}
@@ -0,0 +1,34 @@
+package bus
+
+import (
+	"context"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/transaction"
+)
+
+// TransactionalCommandBus represents bus.CommandBus decorator handling every command inside transaction.UnitOfWork.
+type TransactionalCommandBus struct {
+	commandBus bus.CommandBus
+	unitOfWork transaction.UnitOfWork
+}
+
+// NewTransactionalCommandBus creates a new TransactionalCommandBus.
+func NewTransactionalCommandBus(commandBus bus.CommandBus, unitOfWork transaction.UnitOfWork) TransactionalCommandBus {
+	return TransactionalCommandBus{
+		commandBus: commandBus,
+		unitOfWork: unitOfWork,
+	}
+}
+
+// Dispatch implements bus.CommandBus.Dispatch method. Changes are committed only if handler succeeds.
+func (b TransactionalCommandBus) Dispatch(ctx context.Context, command bus.Command) error {
+	return b.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return b.commandBus.Dispatch(ctx, command)
+	})
+}
+
+// Register implements bus.CommandBus.Register method.
+func (b TransactionalCommandBus) Register(commandType bus.CommandType, handler bus.CommandHandler) error {
+	return b.commandBus.Register(commandType, handler)
+}
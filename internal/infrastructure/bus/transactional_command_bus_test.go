@@ -0,0 +1,56 @@
+package bus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUnitOfWork struct {
+	committed  bool
+	rolledBack bool
+}
+
+func (u *fakeUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack = true
+
+		return err
+	}
+
+	u.committed = true
+
+	return nil
+}
+
+func TestTransactionalCommandBusCommitSuccess(t *testing.T) {
+	// assign
+	unitOfWork := new(fakeUnitOfWork)
+	transactionalCommandBus := NewTransactionalCommandBus(NewInMemoryCommandBus(), unitOfWork)
+	require.NoError(t, transactionalCommandBus.Register(testCommandType, testCommandHandler{}))
+
+	// act
+	err := transactionalCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, unitOfWork.committed)
+	assert.False(t, unitOfWork.rolledBack)
+}
+
+func TestTransactionalCommandBusRollbackOnHandlerError(t *testing.T) {
+	// assign
+	unitOfWork := new(fakeUnitOfWork)
+	transactionalCommandBus := NewTransactionalCommandBus(NewInMemoryCommandBus(), unitOfWork)
+	require.NoError(t, transactionalCommandBus.Register(testCommandType, testCommandHandler{err: errTestHandlerFailed}))
+
+	// act
+	err := transactionalCommandBus.Dispatch(context.Background(), testCommand{})
+
+	// assert
+	assert.ErrorIs(t, err, errTestHandlerFailed)
+	assert.False(t, unitOfWork.committed)
+	assert.True(t, unitOfWork.rolledBack)
+}
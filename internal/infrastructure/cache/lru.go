@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// entry represents value stored in LRU.
+type entry struct {
+	key       string
+	value     any
+	tag       string
+	expiresAt time.Time
+}
+
+// LRU represents bounded in memory least recently used cache with per entry expiration.
+// Entries can be tagged to invalidate all of them at once, e.g. every entry related to an aggregate.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	tags     map[string]map[string]struct{}
+	order    *list.List
+	now      func() time.Time
+}
+
+// NewLRU creates a new LRU holding at most capacity entries.
+func NewLRU(capacity uint) *LRU {
+	return &LRU{
+		capacity: int(capacity),
+		items:    make(map[string]*list.Element),
+		tags:     make(map[string]map[string]struct{}),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get returns not expired value stored under key and marks it as recently used.
+func (c *LRU) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := element.Value.(*entry)
+	if !c.now().Before(e.expiresAt) {
+		c.remove(element)
+
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	return e.value, true
+}
+
+// Set stores value under key for ttl duration, evicting least recently used entry if cache is full.
+func (c *LRU) Set(key string, value any, ttl time.Duration, tag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.capacity <= 0 {
+		return
+	}
+
+	if element, ok := c.items[key]; ok {
+		c.remove(element)
+	}
+
+	element := c.order.PushFront(&entry{key: key, value: value, tag: tag, expiresAt: c.now().Add(ttl)})
+	c.items[key] = element
+
+	if tag != "" {
+		if _, ok := c.tags[tag]; !ok {
+			c.tags[tag] = make(map[string]struct{})
+		}
+
+		c.tags[tag][key] = struct{}{}
+	}
+
+	if c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+// InvalidateTag removes every entry stored with tag.
+func (c *LRU) InvalidateTag(tag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.tags[tag] {
+		if element, ok := c.items[key]; ok {
+			c.remove(element)
+		}
+	}
+}
+
+// Len returns number of stored entries including expired ones not evicted yet.
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// remove deletes element from all indexes. Must be called with mutex locked.
+func (c *LRU) remove(element *list.Element) {
+	e := element.Value.(*entry)
+
+	c.order.Remove(element)
+	delete(c.items, e.key)
+
+	if keys, ok := c.tags[e.tag]; ok {
+		delete(keys, e.key)
+
+		if len(keys) == 0 {
+			delete(c.tags, e.tag)
+		}
+	}
+}
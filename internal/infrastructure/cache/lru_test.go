@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU(t *testing.T) {
+	t.Parallel()
+
+	t.Run("test get stored value success", testGetStoredValueSuccess)
+	t.Run("test get expired value miss", testGetExpiredValueMiss)
+	t.Run("test least recently used value evicted", testLeastRecentlyUsedValueEvicted)
+	t.Run("test invalidate tag", testInvalidateTag)
+}
+
+func testGetStoredValueSuccess(t *testing.T) {
+	// assign
+	lru := NewLRU(2)
+
+	// act
+	lru.Set("key", "value", time.Minute, "")
+	value, ok := lru.Get("key")
+
+	// assert
+	assert.True(t, ok)
+	assert.Equal(t, "value", value)
+}
+
+func testGetExpiredValueMiss(t *testing.T) {
+	// assign
+	now := time.Now()
+	lru := NewLRU(2)
+	lru.now = func() time.Time { return now }
+
+	// act
+	lru.Set("key", "value", time.Minute, "")
+	now = now.Add(time.Minute)
+	value, ok := lru.Get("key")
+
+	// assert
+	assert.False(t, ok)
+	assert.Nil(t, value)
+	assert.Equal(t, 0, lru.Len())
+}
+
+func testLeastRecentlyUsedValueEvicted(t *testing.T) {
+	// assign
+	lru := NewLRU(2)
+
+	// act
+	lru.Set("first", 1, time.Minute, "")
+	lru.Set("second", 2, time.Minute, "")
+	_, _ = lru.Get("first")
+	lru.Set("third", 3, time.Minute, "")
+
+	_, firstOk := lru.Get("first")
+	_, secondOk := lru.Get("second")
+	_, thirdOk := lru.Get("third")
+
+	// assert
+	assert.True(t, firstOk)
+	assert.False(t, secondOk)
+	assert.True(t, thirdOk)
+	assert.Equal(t, 2, lru.Len())
+}
+
+func testInvalidateTag(t *testing.T) {
+	// assign
+	lru := NewLRU(10)
+
+	// act
+	lru.Set("first", 1, time.Minute, "aggregate")
+	lru.Set("second", 2, time.Minute, "aggregate")
+	lru.Set("third", 3, time.Minute, "another_aggregate")
+	lru.InvalidateTag("aggregate")
+
+	_, firstOk := lru.Get("first")
+	_, secondOk := lru.Get("second")
+	_, thirdOk := lru.Get("third")
+
+	// assert
+	assert.False(t, firstOk)
+	assert.False(t, secondOk)
+	assert.True(t, thirdOk)
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/export"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+)
+
+const exportUsage = `Usage:
+  export -output verifications.csv [-format csv|ndjson] [-columns uuid,status,...]
+         [-status draft|approved|declined|cancelled] [-kind identity|document]
+         [-created-from 2023-01-01T00:00:00Z] [-created-to 2023-02-01T00:00:00Z] [-sort -createdAt]`
+
+var (
+	ErrInvalidExportArguments = errors.New("invalid export arguments")
+	ErrExportFailed           = errors.New("export failed")
+)
+
+// RunExport open database connection and writes verifications matching flags given in args to output file.
+// Partially written file is removed when export fails.
+func RunExport(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(out)
+	output := flags.String("output", "", "file to write verifications to")
+	format := flags.String("format", export.FormatCSV, "export format: csv or ndjson")
+	columns := flags.String("columns", strings.Join(export.Columns, ","), "comma separated exported columns")
+	status := flags.String("status", "", "filter by status")
+	kind := flags.String("kind", "", "filter by kind")
+	createdFrom := flags.String("created-from", "", "export verifications created at or after RFC 3339 date-time")
+	createdTo := flags.String("created-to", "", "export verifications created before RFC 3339 date-time")
+	sort := flags.String("sort", query.ListVerificationsDefaultSort, "sort field optionally prefixed with - for descending order")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s\n%s", ErrInvalidExportArguments, err, exportUsage)
+	}
+
+	if *output == "" {
+		return fmt.Errorf("%w: output file is required\n%s", ErrInvalidExportArguments, exportUsage)
+	}
+
+	filter := query.ExportVerificationsFilter{
+		Status:  *status,
+		Kind:    *kind,
+		Sort:    *sort,
+		Format:  *format,
+		Columns: strings.Split(*columns, ","),
+	}
+
+	var err error
+
+	if filter.CreatedFrom, err = parseTimeFlag(*createdFrom); err != nil {
+		return fmt.Errorf("%w: created-from: %s", ErrInvalidExportArguments, err)
+	}
+
+	if filter.CreatedTo, err = parseTimeFlag(*createdTo); err != nil {
+		return fmt.Errorf("%w: created-to: %s", ErrInvalidExportArguments, err)
+	}
+
+	if err := query.NewExportVerificationsQuery(filter, nil).Validate(); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidExportArguments, err)
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrExportFailed, err)
+	}
+
+	exported, err := exportVerifications(filter, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(*output)
+
+		return fmt.Errorf("%w: %s", ErrExportFailed, err)
+	}
+
+	fmt.Fprintf(out, "Verifications exported to %s: %d.\n", *output, exported)
+
+	return nil
+}
+
+// exportVerifications streams verifications matching filter to file through buffered writer.
+func exportVerifications(filter query.ExportVerificationsFilter, file io.Writer) (uint, error) {
+	writer := bufio.NewWriter(file)
+	exportVerificationsQuery := query.NewExportVerificationsQuery(filter, writer)
+
+	con, err := getConnection()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrDatabaseConnectionFailed, err)
+	}
+
+	defer func() {
+		if con != nil {
+			_ = con.Close()
+		}
+	}()
+
+	exportVerificationsQueryHandler := query.NewExportVerificationsQueryHandler(
+		postgres.NewVerificationRepository(con, cliDatabaseTimeout),
+	)
+
+	exported, err := exportVerificationsQueryHandler.Handle(context.Background(), exportVerificationsQuery)
+	if err != nil {
+		return exported, err
+	}
+
+	return exported, writer.Flush()
+}
+
+// parseTimeFlag parses optional RFC 3339 date-time flag value.
+func parseTimeFlag(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/export"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/importer"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+)
+
+const importUsage = `Usage:
+  import -input verifications.csv [-format csv|ndjson] [-rejects verifications.csv.rejects.csv]
+         [-batch-size 1000] [-dry-run]`
+
+var (
+	ErrInvalidImportArguments = errors.New("invalid import arguments")
+	ErrImportFailed           = errors.New("import failed")
+)
+
+// RunImport open database connection and imports verifications of input file given in args.
+// Invalid and already existing verifications are written to rejects file, which is removed if nothing is rejected.
+// Format defaults to input file extension. Dry run validates the whole file without storing anything.
+func RunImport(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(out)
+	input := flags.String("input", "", "file to read verifications from")
+	format := flags.String("format", "", "import format: csv or ndjson, defaults to input file extension")
+	rejects := flags.String("rejects", "", "file to write rejected lines to, defaults to input file with .rejects.csv suffix")
+	batchSize := flags.Uint("batch-size", importer.DefaultBatchSize, "number of verifications stored at once")
+	dryRun := flags.Bool("dry-run", false, "validate input file without storing verifications")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s\n%s", ErrInvalidImportArguments, err, importUsage)
+	}
+
+	if *input == "" {
+		return fmt.Errorf("%w: input file is required\n%s", ErrInvalidImportArguments, importUsage)
+	}
+
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(*input), ".")
+	}
+
+	if err := export.ValidateFormat(*format); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidImportArguments, err)
+	}
+
+	if *batchSize == 0 {
+		return fmt.Errorf("%w: batch size must be positive", ErrInvalidImportArguments)
+	}
+
+	if *rejects == "" {
+		*rejects = *input + ".rejects.csv"
+	}
+
+	inputFile, err := os.Open(*input)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrImportFailed, err)
+	}
+	defer inputFile.Close()
+
+	rejectsFile, err := os.Create(*rejects)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrImportFailed, err)
+	}
+
+	result, err := importVerifications(*format, inputFile, rejectsFile, importer.Options{
+		BatchSize: *batchSize,
+		DryRun:    *dryRun,
+	})
+	if closeErr := rejectsFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if result.Rejected == 0 {
+		_ = os.Remove(*rejects)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrImportFailed, err)
+	}
+
+	verb := "imported"
+	if *dryRun {
+		verb = "valid for import (dry run)"
+	}
+
+	fmt.Fprintf(out, "Verifications read: %d, %s: %d, rejected: %d.\n", result.Read, verb, result.Imported, result.Rejected)
+
+	if result.Rejected > 0 {
+		fmt.Fprintf(out, "Rejected lines written to %s.\n", *rejects)
+	}
+
+	return nil
+}
+
+// importVerifications reads verifications of format from input file through buffered reader and stores them in batches.
+func importVerifications(format string, input io.Reader, rejects io.Writer, options importer.Options) (importer.Result, error) {
+	reader, err := importer.NewReader(format, bufio.NewReader(input))
+	if err != nil {
+		return importer.Result{}, err
+	}
+
+	rejectsWriter := bufio.NewWriter(rejects)
+	rejectWriter := importer.NewRejectWriter(rejectsWriter)
+
+	cfg, err := getConfig()
+	if err != nil {
+		return importer.Result{}, err
+	}
+
+	con, err := getConnection()
+	if err != nil {
+		return importer.Result{}, fmt.Errorf("%w: %v", ErrDatabaseConnectionFailed, err)
+	}
+
+	defer func() {
+		if con != nil {
+			_ = con.Close()
+		}
+	}()
+
+	verificationImporter := importer.NewImporter(
+		postgres.NewVerificationImportRepository(
+			con,
+			cliDatabaseTimeout,
+			postgres.NewVerificationViewRepository(con, cliDatabaseTimeout, cfg.SearchLanguage),
+		),
+		options,
+	)
+
+	result, err := verificationImporter.Import(context.Background(), reader, rejectWriter)
+	if flushErr := rejectWriter.Flush(); err == nil {
+		err = flushErr
+	}
+
+	if flushErr := rejectsWriter.Flush(); err == nil {
+		err = flushErr
+	}
+
+	return result, err
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
@@ -18,6 +19,9 @@ var (
 	ErrMigrationFailed          = errors.New("migration failed")
 )
 
+// cliDatabaseTimeout limits single database query of CLI commands.
+const cliDatabaseTimeout = 10 * time.Second
+
 const migrationsSourceURL = "file://migrations"
 const migrationsDriverName = "postgres"
 
@@ -45,9 +49,8 @@ func RunMigrate() error {
 
 // getConnection parse environment config and open database connection.
 func getConnection() (*sql.DB, error) {
-	var cfg config.Config
-
-	if err := envconfig.Process("", &cfg); err != nil {
+	cfg, err := getConfig()
+	if err != nil {
 		return nil, err
 	}
 
@@ -59,6 +62,17 @@ func getConnection() (*sql.DB, error) {
 	return db, nil
 }
 
+// getConfig parse environment config.
+func getConfig() (config.Config, error) {
+	var cfg config.Config
+
+	if err := envconfig.Process("", &cfg); err != nil {
+		return config.Config{}, err
+	}
+
+	return cfg, nil
+}
+
 // migrateUp execute new migrations.
 func migrateUp(connection *sql.DB) error {
 	driver, err := postgres.WithInstance(connection, &postgres.Config{})
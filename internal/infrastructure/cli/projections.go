@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+)
+
+const projectionsUsage = `Usage:
+  projections rebuild`
+
+var (
+	ErrInvalidProjectionsArguments = errors.New("invalid projections arguments")
+	ErrProjectionRebuildFailed     = errors.New("projection rebuild failed")
+)
+
+// RunProjections open database connection and executes read model management subcommand given in args.
+func RunProjections(args []string, out io.Writer) error {
+	if len(args) != 1 || args[0] != "rebuild" {
+		return fmt.Errorf("%w\n%s", ErrInvalidProjectionsArguments, projectionsUsage)
+	}
+
+	cfg, err := getConfig()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseConnectionFailed, err)
+	}
+
+	con, err := getConnection()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseConnectionFailed, err)
+	}
+
+	defer func() {
+		if con != nil {
+			_ = con.Close()
+		}
+	}()
+
+	verificationViewRepository := postgres.NewVerificationViewRepository(con, cliDatabaseTimeout, cfg.SearchLanguage)
+
+	var rebuilt int64
+
+	err = postgres.NewUnitOfWork(con).Do(context.Background(), func(ctx context.Context) error {
+		rebuilt, err = verificationViewRepository.Rebuild(ctx)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrProjectionRebuildFailed, err)
+	}
+
+	fmt.Fprintf(out, "Verification views rebuilt: %d.\n", rebuilt)
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+)
+
+const schedulerUsage = `Usage:
+  scheduler list [-status scheduled|completed|failed|cancelled] [-limit 100]
+  scheduler cancel <id>
+  scheduler trigger <id>`
+
+var ErrInvalidSchedulerArguments = errors.New("invalid scheduler arguments")
+
+// RunScheduler open database connection and executes scheduled commands management subcommand given in args.
+func RunScheduler(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w\n%s", ErrInvalidSchedulerArguments, schedulerUsage)
+	}
+
+	con, err := getConnection()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseConnectionFailed, err)
+	}
+
+	defer func() {
+		if con != nil {
+			_ = con.Close()
+		}
+	}()
+
+	repository := postgres.NewScheduledCommandRepository(con, cliDatabaseTimeout)
+	ctx := context.Background()
+
+	switch subcommand, rest := args[0], args[1:]; subcommand {
+	case "list":
+		return listScheduledCommands(ctx, repository, rest, out)
+	case "cancel", "trigger":
+		if len(rest) != 1 {
+			return fmt.Errorf("%w: %s expects scheduled command id\n%s", ErrInvalidSchedulerArguments, subcommand, schedulerUsage)
+		}
+
+		if subcommand == "cancel" {
+			err = repository.Cancel(ctx, rest[0])
+		} else {
+			err = repository.Trigger(ctx, rest[0], time.Now())
+		}
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "Scheduled command %s: %s succeeded.\n", rest[0], subcommand)
+
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown subcommand %q\n%s", ErrInvalidSchedulerArguments, subcommand, schedulerUsage)
+	}
+}
+
+// listScheduledCommands prints scheduled commands as a table.
+func listScheduledCommands(ctx context.Context, repository scheduler.Repository, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	flags.SetOutput(out)
+	status := flags.String("status", "", "filter by status")
+	limit := flags.Uint("limit", 100, "maximum number of listed commands")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSchedulerArguments, err)
+	}
+
+	filter := scheduler.Filter{Limit: *limit}
+
+	if *status != "" {
+		parsedStatus, err := scheduler.NewStatus(*status)
+		if err != nil {
+			return err
+		}
+
+		filter.Status = parsedStatus
+	}
+
+	entries, err := repository.List(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tCOMMAND\tSTATUS\tRUN AT\tCRON\tRUNS\tLAST ERROR")
+
+	for _, entry := range entries {
+		fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			entry.ID,
+			entry.CommandType,
+			entry.Status,
+			entry.RunAt.Format(time.RFC3339),
+			entry.Cron,
+			entry.Runs,
+			entry.LastError,
+		)
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,100 @@
+package infrastructure
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const (
+	ETagHeader            = "ETag"
+	LastModifiedHeader    = "Last-Modified"
+	IfNoneMatchHeader     = "If-None-Match"
+	IfModifiedSinceHeader = "If-Modified-Since"
+)
+
+// etagLength is the number of content hash bytes used in entity tag.
+const etagLength = 16
+
+// ConditionalResponse serializes response data to json with http.StatusOK status code like Marshall,
+// tagging it with strong ETag computed from serialized content and Last-Modified date when lastModified is not zero.
+// Client copy which is still current according to "If-None-Match" or "If-Modified-Since" headers
+// is confirmed with http.StatusNotModified without body.
+func (a *Application) ConditionalResponse(w http.ResponseWriter, r *http.Request, data any, lastModified time.Time) error {
+	content, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshalling response data of type %s failed: %v", reflect.TypeOf(data), err)
+	}
+
+	etag := ETag(content)
+	w.Header().Set(ETagHeader, etag)
+
+	if !lastModified.IsZero() {
+		w.Header().Set(LastModifiedHeader, lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(r, etag, lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err = w.Write(content); err != nil {
+		return fmt.Errorf("writing response data of type %s failed: %v", reflect.TypeOf(data), err)
+	}
+
+	return nil
+}
+
+// ETag returns strong entity tag of serialized response content.
+func ETag(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return `"` + hex.EncodeToString(hash[:etagLength]) + `"`
+}
+
+// notModified reports whether client copy of resource tagged with etag and modified at lastModified is current.
+// "If-Modified-Since" is ignored when request has "If-None-Match" header (RFC 9110 section 13.1.3).
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if values := r.Header.Values(IfNoneMatchHeader); len(values) > 0 {
+		return matchesETag(values, etag)
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get(IfModifiedSinceHeader))
+	if err != nil {
+		return false
+	}
+
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// matchesETag reports whether "If-None-Match" header values list etag or "*".
+// Weak comparison is used, so weak validators of the same content match as well.
+func matchesETag(values []string, etag string) bool {
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -7,14 +7,57 @@ import (
 
 // Config represents application config defined in environment variables.
 type Config struct {
-	Port             uint8         `default:"80" split_words:"true"`
-	ShutdownTimeout  time.Duration `default:"10s" split_words:"true"`
-	DatabaseUser     string        `default:"user" split_words:"true"`
-	DatabasePassword string        `default:"password" split_words:"true"`
-	DatabaseHost     string        `default:"localhost" split_words:"true"`
-	DatabasePort     uint          `default:"5432" split_words:"true"`
-	DatabaseName     string        `default:"database_name" split_words:"true"`
-	DatabaseTimeout  time.Duration `default:"5s" split_words:"true"`
+	Port                uint8         `default:"80" split_words:"true"`
+	ShutdownTimeout     time.Duration `default:"10s" split_words:"true"`
+	DatabaseUser        string        `default:"user" split_words:"true"`
+	DatabasePassword    string        `default:"password" split_words:"true"`
+	DatabaseHost        string        `default:"localhost" split_words:"true"`
+	DatabasePort        uint          `default:"5432" split_words:"true"`
+	DatabaseName        string        `default:"database_name" split_words:"true"`
+	DatabaseTimeout     time.Duration `default:"5s" split_words:"true"`
+	CommandBusWorkers   uint          `default:"4" split_words:"true"`
+	CommandBusQueueSize uint          `default:"100" split_words:"true"`
+	QueryCacheSize      uint          `default:"1000" split_words:"true"`
+	IdempotencyKeyTTL   time.Duration `default:"24h" split_words:"true"`
+	SagaTimeoutInterval time.Duration `default:"5s" split_words:"true"`
+	SagaStuckAfter      time.Duration `default:"5m" split_words:"true"`
+	VerificationExpiry  time.Duration `default:"72h" split_words:"true"`
+	SchedulerInterval   time.Duration `default:"1s" split_words:"true"`
+	SchedulerBatchSize  uint          `default:"100" split_words:"true"`
+	// SearchLanguage is PostgreSQL text search configuration used to index and search verification views.
+	// Views indexed with another language, e.g. by migrations, are searchable after "projections rebuild".
+	SearchLanguage string `default:"english" split_words:"true"`
+	// InternalPort serves diagnostics like expvar /debug/vars, it must not be exposed outside of the cluster.
+	InternalPort uint16 `default:"8081" split_words:"true"`
+	// IdempotencyKeyPurgeInterval defines how often expired idempotency keys are deleted.
+	IdempotencyKeyPurgeInterval time.Duration `default:"1h" split_words:"true"`
+	// IdempotencyKeyLockTimeout defines how long request with idempotency key is in progress. Retry after it handles
+	// request again, e.g. because process crashed, so it must be longer than any request handling takes.
+	IdempotencyKeyLockTimeout time.Duration `default:"1m" split_words:"true"`
+	// CommandQueueDriver selects background commands storage: "memory" or durable "postgres" queue.
+	CommandQueueDriver            string        `default:"memory" split_words:"true"`
+	CommandQueuePollInterval      time.Duration `default:"5s" split_words:"true"`
+	CommandQueueVisibilityTimeout time.Duration `default:"1m" split_words:"true"`
+	CommandQueueMaxDeliveries     uint          `default:"5" split_words:"true"`
+	// CommandRetryMaxAttempts and backoff settings define default retry policy of commands failed with transient errors.
+	CommandRetryMaxAttempts    uint          `default:"3" split_words:"true"`
+	CommandRetryInitialBackoff time.Duration `default:"100ms" split_words:"true"`
+	CommandRetryMaxBackoff     time.Duration `default:"2s" split_words:"true"`
+	CommandRetryMultiplier     float64       `default:"2" split_words:"true"`
+	CommandRetryJitter         float64       `default:"0.2" split_words:"true"`
+	// CommandRetryAttempts overrides max attempts per command type, e.g. "approve.verification.command:5".
+	CommandRetryAttempts map[string]uint `split_words:"true"`
+	// LegacyRoutesDeprecatedAt and LegacyRoutesSunset are announced in headers of unversioned legacy API routes.
+	LegacyRoutesDeprecatedAt time.Time `default:"2026-11-01T00:00:00Z" split_words:"true"`
+	LegacyRoutesSunset       time.Time `default:"2027-05-01T00:00:00Z" split_words:"true"`
+	// Events settings define how often verification history is polled for new events streamed to clients,
+	// how often idle streams get heartbeat and how many events a slow client may lag behind before it is disconnected.
+	EventsPollInterval      time.Duration `default:"1s" split_words:"true"`
+	EventsHeartbeatInterval time.Duration `default:"15s" split_words:"true"`
+	EventsBatchSize         uint          `default:"100" split_words:"true"`
+	EventsBufferSize        uint          `default:"100" split_words:"true"`
+	// QueryCacheTTLs maps query type to its cache TTL, e.g. "get_by_uuid.verification.query:5s".
+	QueryCacheTTLs map[string]time.Duration `default:"get_by_uuid.verification.query:5s" split_words:"true"`
 }
 
 // PostgresDatabaseDsn transform database environment variables to PostgreSQL DSN connection string.
@@ -1,5 +1,19 @@
 package infrastructure
 
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ProblemContentType is the media type of RFC 7807 problem details responses.
+	ProblemContentType = "application/problem+json"
+	// ProblemTypeBasePath prefixes problem type URI references, e.g. "/problems/verification_not_found".
+	ProblemTypeBasePath = "/problems/"
+)
+
 // HttpError represents http errors structure.
 type HttpError struct {
 	Message string `json:"message"`
@@ -30,3 +44,98 @@ type ValidationErrorResponse struct {
 func NewValidationErrorResponse(errors []ValidationError) ValidationErrorResponse {
 	return ValidationErrorResponse{Errors: errors}
 }
+
+// ProblemValidationError represents single invalid field of Problem.
+type ProblemValidationError struct {
+	Code         string `json:"code"`
+	Detail       string `json:"detail"`
+	PropertyPath string `json:"propertyPath"`
+}
+
+// Problem represents RFC 7807 problem details response structure with stable machine-readable Code.
+// Validation problems list invalid fields in Errors.
+type Problem struct {
+	Type     string                   `json:"type"`
+	Title    string                   `json:"title"`
+	Status   int                      `json:"status"`
+	Detail   string                   `json:"detail,omitempty"`
+	Instance string                   `json:"instance,omitempty"`
+	Code     string                   `json:"code"`
+	Errors   []ProblemValidationError `json:"errors,omitempty"`
+}
+
+// NewProblem instantiate the Problem of request r. Type is resolved from code relative to ProblemTypeBasePath.
+func NewProblem(r *http.Request, status int, code, detail string) Problem {
+	return Problem{
+		Type:     ProblemTypeBasePath + code,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: r.URL.Path,
+		Code:     code,
+	}
+}
+
+// AcceptsProblem reports whether client asked for ProblemContentType error responses in "Accept" header.
+// Clients accepting any other media type get legacy HttpErrorResponse format.
+func AcceptsProblem(r *http.Request) bool {
+	for _, value := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(value, ",") {
+			parameters := strings.Split(mediaRange, ";")
+			if !strings.EqualFold(strings.TrimSpace(parameters[0]), ProblemContentType) {
+				continue
+			}
+
+			if !hasZeroQuality(parameters[1:]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasZeroQuality reports whether media range parameters contain "q=0", i.e. media type is not acceptable.
+func hasZeroQuality(parameters []string) bool {
+	for _, parameter := range parameters {
+		name, value, found := strings.Cut(strings.TrimSpace(parameter), "=")
+		if !found || !strings.EqualFold(name, "q") {
+			continue
+		}
+
+		quality, err := strconv.ParseFloat(value, 64)
+
+		return err == nil && quality == 0
+	}
+
+	return false
+}
+
+// WriteErrorResponse writes err with status code in format negotiated by AcceptsProblem.
+func WriteErrorResponse(w http.ResponseWriter, r *http.Request, status int, err error) error {
+	return writeErrorResponse(w, r, status, ErrorCode(err, status), err.Error())
+}
+
+// writeErrorResponse writes error message with status code and stable code in format negotiated by AcceptsProblem.
+func writeErrorResponse(w http.ResponseWriter, r *http.Request, status int, code, message string) error {
+	if !AcceptsProblem(r) {
+		return writeJSON(w, status, "application/json", NewHttpErrorResponse(message))
+	}
+
+	return writeJSON(w, status, ProblemContentType, NewProblem(r, status, code, message))
+}
+
+// writeJSON writes data serialized to json with status code and content type.
+func writeJSON(w http.ResponseWriter, status int, contentType string, data any) error {
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+
+	_, err = w.Write(content)
+
+	return err
+}
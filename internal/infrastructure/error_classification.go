@@ -0,0 +1,179 @@
+package infrastructure
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/batch"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/events"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	// CodeInternalError is the code of errors hidden from client.
+	CodeInternalError = "internal_error"
+	// CodeValidationFailed is the code of validation errors listing invalid fields.
+	CodeValidationFailed = "validation_failed"
+	// CodeRequired is the code of missing required field.
+	CodeRequired = "required"
+	// CodeInvalidValue is the code of invalid field without more specific code.
+	CodeInvalidValue = "invalid_value"
+)
+
+// statusCodes are fallback codes of errors without their own code answered with specific status code.
+var statusCodes = map[int]string{
+	http.StatusBadRequest:          "bad_request",
+	http.StatusNotFound:            "not_found",
+	http.StatusConflict:            "conflict",
+	http.StatusUnprocessableEntity: CodeValidationFailed,
+	http.StatusServiceUnavailable:  "service_unavailable",
+}
+
+// CodedError represents client error with stable machine-readable Code,
+// used by packages which can not be referenced from errorClasses, e.g. handlers.
+type CodedError struct {
+	Code    string
+	Message string
+}
+
+// NewCodedError creates CodedError.
+func NewCodedError(code, message string) *CodedError {
+	return &CodedError{Code: code, Message: message}
+}
+
+// Error implements error interface.
+func (e *CodedError) Error() string {
+	return e.Message
+}
+
+// classifiedError represents sentinel error with its stable machine-readable code.
+type classifiedError struct {
+	err  error
+	code string
+}
+
+// errorClass represents sentinel errors answered with the same response status code.
+type errorClass struct {
+	status int
+	errs   []classifiedError
+}
+
+// errorClasses lists errors caused by client, their messages are safe to expose.
+// Any other error, e.g. persistence failure, is internal. Codes are part of API and must not be changed.
+var errorClasses = []errorClass{
+	{
+		status: http.StatusBadRequest,
+		errs: []classifiedError{
+			{err: ErrEmptyRequestBody, code: "empty_request_body"},
+			{err: ErrMultipleRequestJsonObjects, code: "multiple_request_json_objects"},
+			{err: ErrInvalidRequestBody, code: "invalid_request_body"},
+		},
+	},
+	{
+		status: http.StatusNotFound,
+		errs: []classifiedError{
+			{err: postgres.ErrVerificationNotFound, code: "verification_not_found"},
+			{err: postgres.ErrJobNotFound, code: "job_not_found"},
+			{err: scheduler.ErrEntryNotFound, code: "scheduled_command_not_found"},
+			{err: deadletter.ErrLetterNotFound, code: "dead_letter_not_found"},
+		},
+	},
+	{
+		status: http.StatusConflict,
+		errs: []classifiedError{
+			{err: aggregate.ErrAlreadyProcessed, code: "verification_already_processed"},
+			{err: aggregate.ErrVerificationAlreadyExists, code: "verification_already_exists"},
+			{err: scheduler.ErrEntryNotScheduled, code: "scheduled_command_not_scheduled"},
+			{err: batch.ErrBatchRolledBack, code: "batch_rolled_back"},
+		},
+	},
+	{
+		status: http.StatusUnprocessableEntity,
+		errs: []classifiedError{
+			{err: bus.ErrValidationFailed, code: CodeValidationFailed},
+			{err: aggregate.ErrInvalidVerificationUUID, code: "invalid_verification_uuid"},
+			{err: aggregate.ErrInvalidVerificationKind, code: "invalid_verification_kind"},
+			{err: aggregate.ErrEmptyDescription, code: "empty_verification_description"},
+			{err: aggregate.ErrInvalidVerificationStatus, code: "invalid_verification_status"},
+			{err: aggregate.ErrEmptyDeclineReason, code: "empty_decline_reason"},
+			{err: aggregate.ErrExternalReferenceTooLong, code: "external_reference_too_long"},
+			{err: aggregate.ErrInvalidVerificationSort, code: "invalid_verification_sort"},
+			{err: scheduler.ErrInvalidCronExpression, code: "invalid_cron_expression"},
+		},
+	},
+	{
+		status: http.StatusServiceUnavailable,
+		errs: []classifiedError{
+			{err: bus.ErrCommandQueueFull, code: "command_queue_full"},
+			{err: bus.ErrCommandBusClosed, code: "command_bus_closed"},
+			{err: events.ErrBrokerClosed, code: "event_stream_closed"},
+		},
+	},
+}
+
+// fieldErrorCodes lists stable codes of generic invalid field errors.
+// Fields invalid because of domain errors get codes of errorClasses. Codes are part of API and must not be changed.
+var fieldErrorCodes = []classifiedError{
+	{err: bus.ErrFieldRequired, code: CodeRequired},
+	{err: bus.ErrFieldOutOfRange, code: "out_of_range"},
+	{err: bus.ErrFieldTooShort, code: "too_short"},
+	{err: bus.ErrFieldTooLong, code: "too_long"},
+	{err: bus.ErrFieldInvalid, code: CodeInvalidValue},
+}
+
+// ClassifyError returns response status code of err, its stable code and message safe to show to client.
+// Unclassified errors are reported with http.StatusInternalServerError, CodeInternalError and generic ErrInternal message.
+func ClassifyError(err error) (int, string, string) {
+	for _, class := range errorClasses {
+		for _, classErr := range class.errs {
+			if errors.Is(err, classErr.err) {
+				return class.status, classErr.code, err.Error()
+			}
+		}
+	}
+
+	return http.StatusInternalServerError, CodeInternalError, ErrInternal.Error()
+}
+
+// ErrorCode returns stable code of err answered with status code.
+// Errors without own code get generic code of the status.
+func ErrorCode(err error, status int) string {
+	var codedError *CodedError
+	if errors.As(err, &codedError) {
+		return codedError.Code
+	}
+
+	if classStatus, code, _ := ClassifyError(err); classStatus == status {
+		return code
+	}
+
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+
+	return CodeInternalError
+}
+
+// fieldErrorCode returns stable code of invalid field error matched with errors.Is,
+// so wrapped errors and detailed messages keep their code. Unknown errors are CodeInvalidValue.
+func fieldErrorCode(err error) string {
+	for _, fieldErr := range fieldErrorCodes {
+		if errors.Is(err, fieldErr.err) {
+			return fieldErr.code
+		}
+	}
+
+	for _, class := range errorClasses {
+		for _, classErr := range class.errs {
+			if errors.Is(err, classErr.err) {
+				return classErr.code
+			}
+		}
+	}
+
+	return CodeInvalidValue
+}
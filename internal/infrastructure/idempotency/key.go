@@ -0,0 +1,55 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Key represents stored Idempotency-Key request with its response.
+// StatusCode is zero while the first request with the key is still handled. In progress key is locked until
+// LockedUntil, after that its request is considered abandoned, e.g. by crashed process, and can be retried.
+type Key struct {
+	Key         string
+	Fingerprint string
+	StatusCode  int
+	Header      http.Header
+	Body        []byte
+	CreatedAt   time.Time
+	LockedUntil time.Time
+	ExpiresAt   time.Time
+}
+
+// NewKey creates a new in progress Key locked for lockTimeout and expiring after ttl.
+func NewKey(key, fingerprint string, ttl, lockTimeout time.Duration) *Key {
+	now := time.Now()
+
+	return &Key{
+		Key:         key,
+		Fingerprint: fingerprint,
+		Header:      make(http.Header),
+		CreatedAt:   now,
+		LockedUntil: now.Add(lockTimeout),
+		ExpiresAt:   now.Add(ttl),
+	}
+}
+
+// InProgress reports whether response for the key is not stored yet.
+func (k *Key) InProgress() bool {
+	return k.StatusCode == 0
+}
+
+// Store defines the expected behaviour for an idempotency keys storage.
+type Store interface {
+	// Reserve stores a new key. It returns false if not expired key with the same value already exists,
+	// unless that key is in progress with the same fingerprint and its lock has expired.
+	Reserve(ctx context.Context, key *Key) (bool, error)
+	// Get returns not expired key.
+	Get(ctx context.Context, key string) (*Key, error)
+	// Complete stores response of the key.
+	Complete(ctx context.Context, key *Key) error
+	// Release removes the key, so the request can be retried.
+	Release(ctx context.Context, key string) error
+	// DeleteExpired removes keys expired at now and returns the number of removed keys.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,60 @@
+package idempotency
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Purger periodically deletes expired keys from Store in background.
+type Purger struct {
+	store    Store
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewPurger creates a new Purger and starts deleting expired keys every interval.
+func NewPurger(store Store, interval time.Duration) *Purger {
+	p := &Purger{
+		store: store,
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+
+	go p.purge(interval)
+
+	return p
+}
+
+// Shutdown stops purging and waits until the current round is finished.
+func (p *Purger) Shutdown(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.stop) })
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// purge deletes expired keys until Shutdown is called.
+func (p *Purger) purge(interval time.Duration) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case now := <-ticker.C:
+			if _, err := p.store.DeleteExpired(context.Background(), now); err != nil {
+				log.Printf("expired idempotency keys deleting failed: %s", err)
+			}
+		}
+	}
+}
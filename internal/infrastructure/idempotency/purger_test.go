@@ -0,0 +1,52 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// purgedStore is a Store signalling every DeleteExpired call.
+type purgedStore struct {
+	purged chan time.Time
+}
+
+func (s *purgedStore) Reserve(context.Context, *Key) (bool, error) { return true, nil }
+
+func (s *purgedStore) Get(context.Context, string) (*Key, error) {
+	return &Key{Header: make(http.Header)}, nil
+}
+
+func (s *purgedStore) Complete(context.Context, *Key) error { return nil }
+
+func (s *purgedStore) Release(context.Context, string) error { return nil }
+
+func (s *purgedStore) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	select {
+	case s.purged <- now:
+	default:
+	}
+
+	return 0, nil
+}
+
+func TestPurgerDeletesExpiredKeysUntilShutdown(t *testing.T) {
+	// assign
+	store := &purgedStore{purged: make(chan time.Time, 1)}
+	purger := NewPurger(store, time.Millisecond)
+
+	// act
+	select {
+	case <-store.purged:
+	case <-time.After(time.Second):
+		t.Fatal("expired idempotency keys are not deleted")
+	}
+
+	err := purger.Shutdown(context.Background())
+
+	// assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+// CommandQueueChannel is the PostgreSQL notification channel workers are woken up by when command is enqueued.
+const CommandQueueChannel = "command_queue"
+
+var ErrQueuedCommandPersistFailed = errors.New("error trying to persist queued command to database")
+
+// CommandQueue is a PostgreSQL bus.CommandQueue implementation.
+// Acknowledged commands are deleted, so every row is a command waiting for run or being handled.
+type CommandQueue struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewCommandQueue initializes a PostgreSQL-based implementation of bus.CommandQueue.
+func NewCommandQueue(db *sql.DB, dbTimeout time.Duration) *CommandQueue {
+	return &CommandQueue{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Enqueue implements the bus.CommandQueue.Enqueue() method.
+// Insert and notification share transaction, so listeners are notified only when command is committed.
+func (q *CommandQueue) Enqueue(ctx context.Context, command *bus.QueuedCommand) error {
+	const query = `
+		INSERT INTO command_queue (id, command_type, payload, ordering_key, deliveries, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, q.dbTimeout)
+	defer cancel()
+
+	return NewUnitOfWork(q.db).Do(ctxTimeout, func(ctx context.Context) error {
+		_, err := conn(ctx, q.db).ExecContext(
+			ctx,
+			query,
+			command.ID,
+			command.CommandType,
+			command.Payload,
+			command.OrderingKey,
+			command.Deliveries,
+			command.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", ErrQueuedCommandPersistFailed, err)
+		}
+
+		if _, err := conn(ctx, q.db).ExecContext(ctx, "SELECT pg_notify($1, '')", CommandQueueChannel); err != nil {
+			return fmt.Errorf("%s: %w", ErrQueuedCommandPersistFailed, err)
+		}
+
+		return nil
+	})
+}
+
+// Claim implements the bus.CommandQueue.Claim() method.
+// Candidate row is locked with FOR UPDATE SKIP LOCKED, so concurrent workers claim different commands,
+// and it is skipped while any earlier row with the same ordering key exists.
+func (q *CommandQueue) Claim(
+	ctx context.Context,
+	now time.Time,
+	visibilityTimeout time.Duration,
+) (*bus.QueuedCommand, bool, error) {
+	const query = `
+		UPDATE command_queue SET locked_until = $2, deliveries = deliveries + 1
+		WHERE seq = (
+			SELECT q.seq FROM command_queue q
+			WHERE (q.locked_until IS NULL OR q.locked_until <= $1)
+				AND (q.ordering_key = '' OR NOT EXISTS (
+					SELECT 1 FROM command_queue p WHERE p.ordering_key = q.ordering_key AND p.seq < q.seq
+				))
+			ORDER BY q.seq
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, command_type, payload, ordering_key, deliveries, created_at`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, q.dbTimeout)
+	defer cancel()
+
+	var command bus.QueuedCommand
+
+	err := q.db.QueryRowContext(ctxTimeout, query, now, now.Add(visibilityTimeout)).Scan(
+		&command.ID,
+		&command.CommandType,
+		&command.Payload,
+		&command.OrderingKey,
+		&command.Deliveries,
+		&command.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("%s: %w", ErrQueuedCommandPersistFailed, err)
+	}
+
+	return &command, true, nil
+}
+
+// Extend implements the bus.CommandQueue.Extend() method.
+// Deliveries are incremented by every claim, so they match only while command is claimed by the caller.
+func (q *CommandQueue) Extend(ctx context.Context, command *bus.QueuedCommand, lockedUntil time.Time) error {
+	const query = "UPDATE command_queue SET locked_until = $3 WHERE id = $1 AND deliveries = $2"
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, q.dbTimeout)
+	defer cancel()
+
+	result, err := q.db.ExecContext(ctxTimeout, query, command.ID, command.Deliveries, lockedUntil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrQueuedCommandPersistFailed, err)
+	}
+
+	return leaseResult(result)
+}
+
+// Ack implements the bus.CommandQueue.Ack() method.
+func (q *CommandQueue) Ack(ctx context.Context, command *bus.QueuedCommand) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, q.dbTimeout)
+	defer cancel()
+
+	result, err := q.db.ExecContext(
+		ctxTimeout,
+		"DELETE FROM command_queue WHERE id = $1 AND deliveries = $2",
+		command.ID,
+		command.Deliveries,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrQueuedCommandPersistFailed, err)
+	}
+
+	return leaseResult(result)
+}
+
+// leaseResult reports bus.ErrCommandLeaseLost if statement guarded by command deliveries affected no row.
+func leaseResult(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrQueuedCommandPersistFailed, err)
+	}
+
+	if affected == 0 {
+		return bus.ErrCommandLeaseLost
+	}
+
+	return nil
+}
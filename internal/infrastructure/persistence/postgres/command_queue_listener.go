@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// CommandQueueListener turns PostgreSQL notifications of CommandQueueChannel into worker wakeups.
+type CommandQueueListener struct {
+	listener *pq.Listener
+	wakeups  chan struct{}
+	done     chan struct{}
+}
+
+// NewCommandQueueListener connects to database with dsn and listens to CommandQueueChannel.
+// Connection is restored automatically with backoff between minReconnect and maxReconnect.
+func NewCommandQueueListener(dsn string, minReconnect, maxReconnect time.Duration) (*CommandQueueListener, error) {
+	listener := pq.NewListener(dsn, minReconnect, maxReconnect, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("command queue listener event %d: %s", event, err)
+		}
+	})
+
+	if err := listener.Listen(CommandQueueChannel); err != nil {
+		_ = listener.Close()
+
+		return nil, err
+	}
+
+	l := &CommandQueueListener{
+		listener: listener,
+		wakeups:  make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+
+	go l.forward()
+
+	return l, nil
+}
+
+// Wakeups returns channel receiving a value when command is enqueued. Notifications arriving
+// while previous wakeup is not consumed are coalesced, because one wakeup makes worker drain the queue.
+func (l *CommandQueueListener) Wakeups() <-chan struct{} {
+	return l.wakeups
+}
+
+// Close stops listening and closes database connection.
+func (l *CommandQueueListener) Close() error {
+	err := l.listener.Close()
+	<-l.done
+
+	return err
+}
+
+// forward converts notifications to wakeups until listener is closed.
+// Reconnection is reported with nil notification and wakes workers as well, since notifications could be lost.
+func (l *CommandQueueListener) forward() {
+	defer close(l.done)
+
+	for range l.listener.Notify {
+		select {
+		case l.wakeups <- struct{}{}:
+		default:
+		}
+	}
+}
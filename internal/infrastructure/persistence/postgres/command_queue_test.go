@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+func TestCommandQueueAckGuardedByDeliveries(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	queue := NewCommandQueue(db, time.Second)
+	command := &bus.QueuedCommand{ID: "1", Deliveries: 2}
+
+	// act
+	err := queue.Ack(context.Background(), command)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(t, rec.statements[0], "DELETE FROM command_queue WHERE id = $1 AND deliveries = $2")
+	require.Len(t, rec.args, 1)
+	assert.Equal(t, int64(2), rec.args[0][1].Value)
+}
+
+func TestCommandQueueExtendGuardedByDeliveries(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	queue := NewCommandQueue(db, time.Second)
+	command := &bus.QueuedCommand{ID: "1", Deliveries: 2}
+	lockedUntil := time.Now().Add(time.Minute)
+
+	// act
+	err := queue.Extend(context.Background(), command, lockedUntil)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(t, rec.statements[0], "SET locked_until = $3 WHERE id = $1 AND deliveries = $2")
+	require.Len(t, rec.args, 1)
+	assert.Equal(t, lockedUntil, rec.args[0][2].Value)
+}
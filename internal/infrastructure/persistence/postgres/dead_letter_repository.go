@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+)
+
+const deadLetterColumns = "id, command_type, payload, error, attempts, created_at"
+
+var ErrDeadLetterPersistFailed = errors.New("error trying to persist dead letter to database")
+
+// DeadLetterRepository is a PostgreSQL deadletter.Repository implementation.
+type DeadLetterRepository struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewDeadLetterRepository initializes a PostgreSQL-based implementation of deadletter.Repository.
+func NewDeadLetterRepository(db *sql.DB, dbTimeout time.Duration) *DeadLetterRepository {
+	return &DeadLetterRepository{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Add implements the deadletter.Repository.Add() method.
+func (r *DeadLetterRepository) Add(ctx context.Context, letter *deadletter.Letter) error {
+	query := "INSERT INTO dead_letters (" + deadLetterColumns + ") VALUES ($1, $2, $3, $4, $5, $6)"
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctxTimeout,
+		query,
+		letter.ID,
+		letter.CommandType,
+		letter.Payload,
+		letter.Error,
+		letter.Attempts,
+		letter.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrDeadLetterPersistFailed, err)
+	}
+
+	return nil
+}
+
+// Get implements the deadletter.Repository.Get() method.
+func (r *DeadLetterRepository) Get(ctx context.Context, id string) (*deadletter.Letter, error) {
+	query := "SELECT " + deadLetterColumns + " FROM dead_letters WHERE id = $1"
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	letter, err := scanDeadLetter(conn(ctx, r.db).QueryRowContext(ctxTimeout, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", deadletter.ErrLetterNotFound, id)
+		}
+
+		return nil, err
+	}
+
+	return letter, nil
+}
+
+// List implements the deadletter.Repository.List() method.
+func (r *DeadLetterRepository) List(ctx context.Context, limit uint) ([]*deadletter.Letter, error) {
+	query := "SELECT " + deadLetterColumns + " FROM dead_letters ORDER BY created_at DESC, id LIMIT $1"
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var letters []*deadletter.Letter
+
+	for rows.Next() {
+		letter, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		letters = append(letters, letter)
+	}
+
+	return letters, rows.Err()
+}
+
+// Delete implements the deadletter.Repository.Delete() method.
+func (r *DeadLetterRepository) Delete(ctx context.Context, id string) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	result, err := conn(ctx, r.db).ExecContext(ctxTimeout, "DELETE FROM dead_letters WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrDeadLetterPersistFailed, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrDeadLetterPersistFailed, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", deadletter.ErrLetterNotFound, id)
+	}
+
+	return nil
+}
+
+// scanDeadLetter scans deadLetterColumns to deadletter.Letter.
+func scanDeadLetter(row scanner) (*deadletter.Letter, error) {
+	var letter deadletter.Letter
+
+	err := row.Scan(
+		&letter.ID,
+		&letter.CommandType,
+		&letter.Payload,
+		&letter.Error,
+		&letter.Attempts,
+		&letter.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &letter, nil
+}
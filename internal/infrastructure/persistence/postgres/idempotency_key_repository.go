@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/idempotency"
+)
+
+var (
+	ErrIdempotencyKeyPersistFailed = errors.New("error trying to persist idempotency key to database")
+	ErrIdempotencyKeyNotFound      = errors.New("idempotency key not found")
+)
+
+// IdempotencyKeyRepository is a PostgreSQL idempotency.Store implementation.
+type IdempotencyKeyRepository struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewIdempotencyKeyRepository initializes a PostgreSQL-based implementation of idempotency.Store.
+func NewIdempotencyKeyRepository(db *sql.DB, dbTimeout time.Duration) *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Reserve implements the idempotency.Store.Reserve() method. Expired key with the same value is replaced,
+// in progress key of the same request is taken over once its lock expires.
+func (r *IdempotencyKeyRepository) Reserve(ctx context.Context, key *idempotency.Key) (bool, error) {
+	const query = `
+		INSERT INTO idempotency_keys (
+			key, fingerprint, status_code, response_headers, response_body, created_at, locked_until, expires_at
+		)
+		VALUES ($1, $2, 0, '{}', NULL, $3, $4, $5)
+		ON CONFLICT (key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint,
+			status_code = 0,
+			response_headers = '{}',
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			locked_until = EXCLUDED.locked_until,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+			OR (
+				idempotency_keys.status_code = 0
+				AND idempotency_keys.locked_until <= EXCLUDED.created_at
+				AND idempotency_keys.fingerprint = EXCLUDED.fingerprint
+			)`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	result, err := r.db.ExecContext(
+		ctxTimeout,
+		query,
+		key.Key,
+		key.Fingerprint,
+		key.CreatedAt,
+		key.LockedUntil,
+		key.ExpiresAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", ErrIdempotencyKeyPersistFailed, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", ErrIdempotencyKeyPersistFailed, err)
+	}
+
+	return affected == 1, nil
+}
+
+// Get implements the idempotency.Store.Get() method.
+func (r *IdempotencyKeyRepository) Get(ctx context.Context, key string) (*idempotency.Key, error) {
+	const query = `
+		SELECT key, fingerprint, status_code, response_headers, COALESCE(response_body, ''), created_at, locked_until,
+			expires_at
+		FROM idempotency_keys
+		WHERE key = $1 AND expires_at > $2`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	var (
+		idempotencyKey idempotency.Key
+		header         []byte
+	)
+
+	err := r.db.QueryRowContext(ctxTimeout, query, key, time.Now()).Scan(
+		&idempotencyKey.Key,
+		&idempotencyKey.Fingerprint,
+		&idempotencyKey.StatusCode,
+		&header,
+		&idempotencyKey.Body,
+		&idempotencyKey.CreatedAt,
+		&idempotencyKey.LockedUntil,
+		&idempotencyKey.ExpiresAt,
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("%w: %s", ErrIdempotencyKeyNotFound, key)
+		default:
+			return nil, err
+		}
+	}
+
+	idempotencyKey.Header = make(http.Header)
+
+	if err := json.Unmarshal(header, &idempotencyKey.Header); err != nil {
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+// Complete implements the idempotency.Store.Complete() method.
+func (r *IdempotencyKeyRepository) Complete(ctx context.Context, key *idempotency.Key) error {
+	const query = `
+		UPDATE idempotency_keys
+		SET status_code = $2, response_headers = $3, response_body = $4
+		WHERE key = $1`
+
+	header, err := json.Marshal(key.Header)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrIdempotencyKeyPersistFailed, err)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	if _, err := r.db.ExecContext(ctxTimeout, query, key.Key, key.StatusCode, header, key.Body); err != nil {
+		return fmt.Errorf("%s: %w", ErrIdempotencyKeyPersistFailed, err)
+	}
+
+	return nil
+}
+
+// Release implements the idempotency.Store.Release() method.
+func (r *IdempotencyKeyRepository) Release(ctx context.Context, key string) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	if _, err := r.db.ExecContext(ctxTimeout, "DELETE FROM idempotency_keys WHERE key = $1", key); err != nil {
+		return fmt.Errorf("%s: %w", ErrIdempotencyKeyPersistFailed, err)
+	}
+
+	return nil
+}
+
+// DeleteExpired implements the idempotency.Store.DeleteExpired() method.
+func (r *IdempotencyKeyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctxTimeout, "DELETE FROM idempotency_keys WHERE expires_at <= $1", now)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", ErrIdempotencyKeyPersistFailed, err)
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres/model"
+)
+
+var (
+	ErrJobPersistFailed = errors.New("error trying to persist job to database")
+	ErrJobNotFound      = errors.New("job not found")
+)
+
+// JobRepository is a PostgreSQL bus.JobRepository implementation.
+// Jobs are deliberately stored outside of UnitOfWork transaction, so status changes are visible to pollers immediately.
+type JobRepository struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewJobRepository initializes a PostgreSQL-based implementation of bus.JobRepository.
+func NewJobRepository(db *sql.DB, dbTimeout time.Duration) *JobRepository {
+	return &JobRepository{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Add implements the bus.JobRepository.Add() method.
+func (r *JobRepository) Add(ctx context.Context, job *bus.Job) error {
+	jobSQLStruct := sqlbuilder.NewStruct(new(model.SQLJob))
+
+	insertBuilder := jobSQLStruct.InsertIntoForTag(model.SQLJobTable, model.SQLJobCreateTag, model.ToSQLJob(job))
+	query, args := insertBuilder.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	if _, err := r.db.ExecContext(ctxTimeout, query, args...); err != nil {
+		return fmt.Errorf("%s: %w", ErrJobPersistFailed, err)
+	}
+
+	return nil
+}
+
+// Update implements the bus.JobRepository.Update() method.
+func (r *JobRepository) Update(ctx context.Context, job *bus.Job) error {
+	jobSQLStruct := sqlbuilder.NewStruct(new(model.SQLJob))
+
+	updateBuilder := jobSQLStruct.UpdateForTag(model.SQLJobTable, model.SQLJobUpdateTag, model.ToSQLJob(job))
+	updateBuilder.Where(updateBuilder.Equal("id", job.ID))
+
+	query, args := updateBuilder.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	if _, err := r.db.ExecContext(ctxTimeout, query, args...); err != nil {
+		return fmt.Errorf("%s: %w", ErrJobPersistFailed, err)
+	}
+
+	return nil
+}
+
+// GetByID implements the bus.JobRepository.GetByID() method.
+func (r *JobRepository) GetByID(ctx context.Context, id string) (*bus.Job, error) {
+	jobSQLStruct := sqlbuilder.NewStruct(new(model.SQLJob))
+
+	selectBuilder := jobSQLStruct.SelectFromForTag(model.SQLJobTable, model.SQLJobGetTag)
+	selectBuilder.Where(selectBuilder.Equal("id", id))
+
+	query, args := selectBuilder.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	var sqlJob model.SQLJob
+
+	err := r.db.QueryRowContext(ctxTimeout, query, args...).Scan(jobSQLStruct.Addr(&sqlJob)...)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("%w: %s", ErrJobNotFound, id)
+		default:
+			return nil, err
+		}
+	}
+
+	return model.ToJob(sqlJob), nil
+}
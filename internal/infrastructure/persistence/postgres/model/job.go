@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+)
+
+const (
+	SQLJobTable     = "jobs"
+	SQLJobCreateTag = "create"
+	SQLJobUpdateTag = "update"
+	SQLJobGetTag    = "get"
+)
+
+// SQLJob represents bus.Job database structure.
+type SQLJob struct {
+	ID          string    `db:"id" fieldtag:"create,get"`
+	CommandType string    `db:"command_type" fieldtag:"create,get"`
+	Status      string    `db:"status" fieldtag:"create,update,get"`
+	Result      string    `db:"result" fieldtag:"create,update,get"`
+	Error       string    `db:"error" fieldtag:"create,update,get"`
+	CreatedAt   time.Time `db:"created_at" fieldtag:"create,get"`
+	UpdatedAt   time.Time `db:"updated_at" fieldtag:"create,update,get"`
+}
+
+// ToSQLJob convert bus.Job to it's sql representation.
+func ToSQLJob(job *bus.Job) SQLJob {
+	return SQLJob{
+		ID:          job.ID,
+		CommandType: string(job.CommandType),
+		Status:      string(job.Status),
+		Result:      job.Result,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+}
+
+// ToJob convert SQLJob to bus.Job.
+func ToJob(sqlJob SQLJob) *bus.Job {
+	return &bus.Job{
+		ID:          sqlJob.ID,
+		CommandType: bus.CommandType(sqlJob.CommandType),
+		Status:      bus.JobStatus(sqlJob.Status),
+		Result:      sqlJob.Result,
+		Error:       sqlJob.Error,
+		CreatedAt:   sqlJob.CreatedAt,
+		UpdatedAt:   sqlJob.UpdatedAt,
+	}
+}
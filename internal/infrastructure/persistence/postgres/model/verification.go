@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -19,23 +20,30 @@ var ErrFailedRestoringVerificationFromDatabase = errors.New("failed restoring ve
 // SQLVerification verification represents aggregate.Verification database structure.
 // separate struct is used because aggregate with VO is hard to persist to database
 type SQLVerification struct {
-	ID            uint32    `db:"id" fieldtag:"get"`
-	UUID          string    `db:"uuid" fieldtag:"create,get"`
-	Kind          string    `db:"kind" fieldtag:"create,get"`
-	Description   string    `db:"description" fieldtag:"create,get"`
-	Status        string    `db:"status" fieldtag:"create,get"`
-	DeclineReason string    `db:"decline_reason" fieldtag:"create,get"`
-	CreatedAt     time.Time `db:"created_at" fieldtag:"create,get"`
+	ID                uint32       `db:"id" fieldtag:"get"`
+	UUID              string       `db:"uuid" fieldtag:"create,get"`
+	Kind              string       `db:"kind" fieldtag:"create,get"`
+	Description       string       `db:"description" fieldtag:"create,get"`
+	Status            string       `db:"status" fieldtag:"create,get"`
+	DeclineReason     string       `db:"decline_reason" fieldtag:"create,get"`
+	ExternalReference string       `db:"external_reference" fieldtag:"create,get"`
+	CreatedAt         time.Time    `db:"created_at" fieldtag:"create,get"`
+	DecidedAt         sql.NullTime `db:"decided_at" fieldtag:"create,get"`
+	UpdatedAt         time.Time    `db:"updated_at" fieldtag:"create,get"`
 }
 
 // ToSQLVerification convert aggregate.Verification to it's sql representation.
+// Dates are stored in UTC, because columns have no time zone.
 func ToSQLVerification(verification *aggregate.Verification) SQLVerification {
 	sqlVerification := SQLVerification{
-		UUID:        verification.UUID().Value(),
-		Kind:        verification.Kind().Value(),
-		Description: verification.Description().Value(),
-		Status:      verification.Status().Value(),
-		CreatedAt:   verification.CreatedAt(),
+		UUID:              verification.UUID().Value(),
+		Kind:              verification.Kind().Value(),
+		Description:       verification.Description().Value(),
+		Status:            verification.Status().Value(),
+		ExternalReference: verification.ExternalReference().Value(),
+		CreatedAt:         verification.CreatedAt().UTC(),
+		DecidedAt:         sql.NullTime{Time: verification.DecidedAt().UTC(), Valid: !verification.DecidedAt().IsZero()},
+		UpdatedAt:         verification.UpdatedAt().UTC(),
 	}
 
 	if verification.DeclineReason().Value() != "" {
@@ -58,11 +66,21 @@ func ToDomainVerification(sqlVerification SQLVerification) (*aggregate.Verificat
 	}
 
 	verification.WithID(sqlVerification.ID)
+	verification.WithCreatedAt(sqlVerification.CreatedAt)
+	verification.WithUpdatedAt(sqlVerification.UpdatedAt)
+
+	if sqlVerification.DecidedAt.Valid {
+		verification.WithDecidedAt(sqlVerification.DecidedAt.Time)
+	}
 
 	if err = verification.WithStatus(sqlVerification.Status); err != nil {
 		return nil, err
 	}
 
+	if err = verification.WithExternalReference(sqlVerification.ExternalReference); err != nil {
+		return nil, err
+	}
+
 	err = verification.WithDeclineReason(sqlVerification.DeclineReason)
 
 	if sqlVerification.DeclineReason != "" && err != nil {
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/saga"
+)
+
+const sagaColumns = "saga_type, aggregate_id, step, status, data, error, timeout_at, version, created_at, updated_at"
+
+var ErrSagaPersistFailed = errors.New("error trying to persist saga to database")
+
+// SagaRepository is a PostgreSQL saga.Repository implementation.
+type SagaRepository struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewSagaRepository initializes a PostgreSQL-based implementation of saga.Repository.
+func NewSagaRepository(db *sql.DB, dbTimeout time.Duration) *SagaRepository {
+	return &SagaRepository{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Get implements the saga.Repository.Get() method.
+func (r *SagaRepository) Get(ctx context.Context, sagaType saga.Type, aggregateID string) (*saga.State, error) {
+	query := "SELECT " + sagaColumns + " FROM sagas WHERE saga_type = $1 AND aggregate_id = $2"
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	state, err := scanSagaState(conn(ctx, r.db).QueryRowContext(ctxTimeout, query, sagaType, aggregateID))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("%w: %s %s", saga.ErrStateNotFound, sagaType, aggregateID)
+		default:
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
+
+// Save implements the saga.Repository.Save() method. State version is used for optimistic locking.
+func (r *SagaRepository) Save(ctx context.Context, state *saga.State) error {
+	const query = `
+		INSERT INTO sagas (` + sagaColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8 + 1, $9, $10)
+		ON CONFLICT (saga_type, aggregate_id) DO UPDATE SET
+			step = EXCLUDED.step,
+			status = EXCLUDED.status,
+			data = EXCLUDED.data,
+			error = EXCLUDED.error,
+			timeout_at = EXCLUDED.timeout_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE sagas.version = $8`
+
+	data, err := json.Marshal(state.Data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrSagaPersistFailed, err)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctxTimeout,
+		query,
+		state.Type,
+		state.AggregateID,
+		state.Step,
+		state.Status,
+		data,
+		state.Error,
+		state.TimeoutAt,
+		state.Version,
+		state.CreatedAt,
+		state.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrSagaPersistFailed, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrSagaPersistFailed, err)
+	}
+
+	if affected == 0 {
+		return saga.ErrConcurrentUpdate
+	}
+
+	state.Version++
+
+	return nil
+}
+
+// ListDue implements the saga.Repository.ListDue() method.
+func (r *SagaRepository) ListDue(ctx context.Context, now time.Time, limit uint) ([]*saga.State, error) {
+	return r.List(ctx, saga.Filter{Status: saga.Running, TimeoutBefore: &now, Limit: limit})
+}
+
+// List implements the saga.Repository.List() method. States are ordered by timeout and update time.
+func (r *SagaRepository) List(ctx context.Context, filter saga.Filter) ([]*saga.State, error) {
+	selectBuilder := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	selectBuilder.Select(sagaColumns).From("sagas")
+
+	if filter.Status != "" {
+		selectBuilder.Where(selectBuilder.Equal("status", filter.Status))
+	}
+
+	if filter.TimeoutBefore != nil {
+		selectBuilder.Where(selectBuilder.LessEqualThan("timeout_at", *filter.TimeoutBefore))
+	}
+
+	selectBuilder.OrderBy("timeout_at ASC NULLS LAST", "updated_at ASC")
+
+	if filter.Limit > 0 {
+		selectBuilder.Limit(int(filter.Limit))
+	}
+
+	query, args := selectBuilder.Build()
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var states []*saga.State
+
+	for rows.Next() {
+		state, err := scanSagaState(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		states = append(states, state)
+	}
+
+	return states, rows.Err()
+}
+
+// scanner is implemented by both sql.Row and sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanSagaState scans sagaColumns to saga.State.
+func scanSagaState(row scanner) (*saga.State, error) {
+	var (
+		state     saga.State
+		data      []byte
+		timeoutAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&state.Type,
+		&state.AggregateID,
+		&state.Step,
+		&state.Status,
+		&data,
+		&state.Error,
+		&timeoutAt,
+		&state.Version,
+		&state.CreatedAt,
+		&state.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeoutAt.Valid {
+		state.TimeoutAt = &timeoutAt.Time
+	}
+
+	if err := json.Unmarshal(data, &state.Data); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
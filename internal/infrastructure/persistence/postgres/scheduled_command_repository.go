@@ -0,0 +1,271 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+)
+
+const (
+	scheduledCommandColumns = "id, command_type, payload, cron, status, run_at, runs, last_run_at, last_error, created_at, updated_at"
+	// scheduledCommandSavepoint separates scheduled command run changes from the entry update in RunNext transaction.
+	scheduledCommandSavepoint = "scheduled_command_run"
+)
+
+var ErrScheduledCommandPersistFailed = errors.New("error trying to persist scheduled command to database")
+
+// ScheduledCommandRepository is a PostgreSQL scheduler.Repository implementation.
+type ScheduledCommandRepository struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewScheduledCommandRepository initializes a PostgreSQL-based implementation of scheduler.Repository.
+func NewScheduledCommandRepository(db *sql.DB, dbTimeout time.Duration) *ScheduledCommandRepository {
+	return &ScheduledCommandRepository{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Add implements the scheduler.Repository.Add() method.
+func (r *ScheduledCommandRepository) Add(ctx context.Context, entry *scheduler.Entry) error {
+	query := "INSERT INTO scheduled_commands (" + scheduledCommandColumns + ") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)"
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctxTimeout,
+		query,
+		entry.ID,
+		entry.CommandType,
+		entry.Payload,
+		entry.Cron,
+		entry.Status,
+		entry.RunAt,
+		entry.Runs,
+		entry.LastRunAt,
+		entry.LastError,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrScheduledCommandPersistFailed, err)
+	}
+
+	return nil
+}
+
+// List implements the scheduler.Repository.List() method. Entries are ordered by run time.
+func (r *ScheduledCommandRepository) List(ctx context.Context, filter scheduler.Filter) ([]*scheduler.Entry, error) {
+	selectBuilder := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	selectBuilder.Select(scheduledCommandColumns).From("scheduled_commands")
+
+	if filter.Status != "" {
+		selectBuilder.Where(selectBuilder.Equal("status", filter.Status))
+	}
+
+	selectBuilder.OrderBy("run_at ASC")
+
+	if filter.Limit > 0 {
+		selectBuilder.Limit(int(filter.Limit))
+	}
+
+	query, args := selectBuilder.Build()
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*scheduler.Entry
+
+	for rows.Next() {
+		entry, err := scanScheduledCommand(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// Cancel implements the scheduler.Repository.Cancel() method.
+func (r *ScheduledCommandRepository) Cancel(ctx context.Context, id string) error {
+	const query = `
+		UPDATE scheduled_commands SET status = $2, updated_at = $3
+		WHERE id = $1 AND status = $4`
+
+	return r.updateScheduled(ctx, id, query, id, scheduler.Cancelled, time.Now(), scheduler.Scheduled)
+}
+
+// Trigger implements the scheduler.Repository.Trigger() method.
+func (r *ScheduledCommandRepository) Trigger(ctx context.Context, id string, now time.Time) error {
+	const query = `
+		UPDATE scheduled_commands SET run_at = $2, updated_at = $2
+		WHERE id = $1 AND status = $3`
+
+	return r.updateScheduled(ctx, id, query, id, now, scheduler.Scheduled)
+}
+
+// RunNext implements the scheduler.Repository.RunNext() method.
+// Entry row stays locked with FOR UPDATE SKIP LOCKED until run is finished, so other instances pick other entries.
+// Run shares the transaction, so command changes are committed together with the entry result.
+// Functions run deferred with UnitOfWork.AfterCommit are called after commit and dropped if run fails.
+func (r *ScheduledCommandRepository) RunNext(
+	ctx context.Context,
+	now time.Time,
+	run func(ctx context.Context, entry *scheduler.Entry) error,
+) (ran bool, err error) {
+	query := "SELECT " + scheduledCommandColumns + ` FROM scheduled_commands
+		WHERE status = $1 AND run_at <= $2
+		ORDER BY run_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", ErrTransactionBeginFailed, err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			rollback(tx)
+			panic(p)
+		}
+
+		if err != nil || !ran {
+			rollback(tx)
+		}
+	}()
+
+	entry, err := scanScheduledCommand(tx.QueryRowContext(ctx, query, scheduler.Scheduled, now))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+scheduledCommandSavepoint); err != nil {
+		return false, err
+	}
+
+	hooks := new(afterCommitHooks)
+	runCtx := context.WithValue(context.WithValue(ctx, txContextKey{}, tx), afterCommitContextKey{}, hooks)
+
+	if runErr := run(runCtx, entry); runErr != nil {
+		if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+scheduledCommandSavepoint); err != nil {
+			return false, err
+		}
+
+		hooks = new(afterCommitHooks)
+	}
+
+	const updateQuery = `
+		UPDATE scheduled_commands
+		SET status = $2, run_at = $3, runs = $4, last_run_at = $5, last_error = $6, updated_at = $7
+		WHERE id = $1`
+
+	_, err = tx.ExecContext(
+		ctx,
+		updateQuery,
+		entry.ID,
+		entry.Status,
+		entry.RunAt,
+		entry.Runs,
+		entry.LastRunAt,
+		entry.LastError,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", ErrScheduledCommandPersistFailed, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("%s: %w", ErrTransactionCommitFailed, err)
+	}
+
+	hooks.run()
+
+	return true, nil
+}
+
+// updateScheduled executes update query of scheduled entry and tells missing entries from not scheduled ones.
+func (r *ScheduledCommandRepository) updateScheduled(ctx context.Context, id, query string, args ...any) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	result, err := conn(ctx, r.db).ExecContext(ctxTimeout, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrScheduledCommandPersistFailed, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrScheduledCommandPersistFailed, err)
+	}
+
+	if affected > 0 {
+		return nil
+	}
+
+	var exists bool
+
+	err = conn(ctx, r.db).
+		QueryRowContext(ctxTimeout, "SELECT EXISTS(SELECT 1 FROM scheduled_commands WHERE id = $1)", id).
+		Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrScheduledCommandPersistFailed, err)
+	}
+
+	if !exists {
+		return fmt.Errorf("%w: %s", scheduler.ErrEntryNotFound, id)
+	}
+
+	return fmt.Errorf("%w: %s", scheduler.ErrEntryNotScheduled, id)
+}
+
+// scanScheduledCommand scans scheduledCommandColumns to scheduler.Entry.
+func scanScheduledCommand(row scanner) (*scheduler.Entry, error) {
+	var (
+		entry     scheduler.Entry
+		lastRunAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.CommandType,
+		&entry.Payload,
+		&entry.Cron,
+		&entry.Status,
+		&entry.RunAt,
+		&entry.Runs,
+		&lastRunAt,
+		&entry.LastError,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastRunAt.Valid {
+		entry.LastRunAt = &lastRunAt.Time
+	}
+
+	return &entry, nil
+}
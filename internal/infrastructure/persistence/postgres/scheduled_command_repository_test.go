@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+)
+
+func newScheduledCommandRow(now time.Time) []driver.Value {
+	return []driver.Value{
+		"1", "test.command", []byte(`{}`), "", string(scheduler.Scheduled), now, int64(0), nil, "", now, now,
+	}
+}
+
+func TestScheduledCommandRepositoryRunNextRunsAfterCommitFunctionsAfterCommit(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	now := time.Now()
+	rec.rows = [][]driver.Value{newScheduledCommandRow(now)}
+
+	repository := NewScheduledCommandRepository(db, time.Second)
+	unitOfWork := NewUnitOfWork(db)
+
+	commitsBeforeHook := -1
+
+	// act
+	ran, err := repository.RunNext(context.Background(), now, func(ctx context.Context, _ *scheduler.Entry) error {
+		unitOfWork.AfterCommit(ctx, func() { commitsBeforeHook = rec.commits })
+
+		return nil
+	})
+
+	// assert
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, 1, commitsBeforeHook)
+}
+
+func TestScheduledCommandRepositoryRunNextDropsAfterCommitFunctionsOfFailedRun(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	now := time.Now()
+	rec.rows = [][]driver.Value{newScheduledCommandRow(now)}
+
+	repository := NewScheduledCommandRepository(db, time.Second)
+	unitOfWork := NewUnitOfWork(db)
+
+	hookCalled := false
+
+	// act
+	ran, err := repository.RunNext(context.Background(), now, func(ctx context.Context, _ *scheduler.Entry) error {
+		unitOfWork.AfterCommit(ctx, func() { hookCalled = true })
+
+		return errTestHandlerFailed
+	})
+
+	// assert
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, 1, rec.commits)
+	assert.False(t, hookCalled)
+}
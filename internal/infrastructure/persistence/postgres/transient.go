@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+
+	"github.com/lib/pq"
+)
+
+// transientErrorCodes lists PostgreSQL error codes of failures which may succeed when statement is retried.
+var transientErrorCodes = map[pq.ErrorCode]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"55P03": true, // lock_not_available
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// transientErrorClasses lists PostgreSQL error classes of failures which may succeed when statement is retried.
+var transientErrorClasses = map[pq.ErrorClass]bool{
+	"08": true, // connection_exception
+	"53": true, // insufficient_resources
+}
+
+// IsTransient reports whether err is caused by temporary database unavailability, e.g. lost connection,
+// deadlock or statement timeout, rather than by data itself. It is used to tell retryable command failures.
+func IsTransient(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return transientErrorCodes[pqErr.Code] || transientErrorClasses[pqErr.Code.Class()]
+	}
+
+	var netErr net.Error
+
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.As(err, &netErr)
+}
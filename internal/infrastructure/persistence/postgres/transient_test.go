@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := map[string]struct {
+		err       error
+		transient bool
+	}{
+		"deadlock": {
+			err:       fmt.Errorf("%s: %w", ErrVerificationPersistFailed, &pq.Error{Code: "40P01"}),
+			transient: true,
+		},
+		"connection failure": {
+			err:       &pq.Error{Code: "08006"},
+			transient: true,
+		},
+		"bad connection": {
+			err:       fmt.Errorf("%s: %w", ErrVerificationPersistFailed, driver.ErrBadConn),
+			transient: true,
+		},
+		"unique violation": {
+			err:       fmt.Errorf("%s: %w", ErrVerificationPersistFailed, &pq.Error{Code: uniqueViolationCode}),
+			transient: false,
+		},
+		"domain error": {
+			err:       aggregate.ErrVerificationAlreadyExists,
+			transient: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// act
+			transient := IsTransient(test.err)
+
+			// assert
+			assert.Equal(t, test.transient, transient)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+var (
+	ErrTransactionBeginFailed  = errors.New("error trying to begin database transaction")
+	ErrTransactionCommitFailed = errors.New("error trying to commit database transaction")
+)
+
+// txContextKey is the context.Context key transaction is stored under.
+type txContextKey struct{}
+
+// afterCommitContextKey is the context.Context key of functions deferred until transaction commit.
+type afterCommitContextKey struct{}
+
+// afterCommitHooks collects functions deferred until transaction commit.
+type afterCommitHooks struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+// run calls collected functions in order they were deferred.
+func (h *afterCommitHooks) run() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, fn := range h.fns {
+		fn()
+	}
+}
+
+// executor is the common interface of *sql.DB and *sql.Tx used by repositories.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// conn returns transaction started by UnitOfWork if context contains one, database connection pool otherwise.
+func conn(ctx context.Context, db *sql.DB) executor {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+// UnitOfWork is a PostgreSQL transaction.UnitOfWork implementation.
+type UnitOfWork struct {
+	db *sql.DB
+}
+
+// NewUnitOfWork initializes a PostgreSQL-based implementation of transaction.UnitOfWork.
+func NewUnitOfWork(db *sql.DB) *UnitOfWork {
+	return &UnitOfWork{
+		db: db,
+	}
+}
+
+// Do implements the transaction.UnitOfWork.Do() method.
+// Transaction is committed if fn succeeds and rolled back if fn returns error or panics.
+// Nested calls join the outer transaction, so only the outermost call commits and runs AfterCommit functions.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrTransactionBeginFailed, err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			rollback(tx)
+			panic(p)
+		}
+	}()
+
+	hooks := new(afterCommitHooks)
+	txCtx := context.WithValue(context.WithValue(ctx, txContextKey{}, tx), afterCommitContextKey{}, hooks)
+
+	if err := fn(txCtx); err != nil {
+		rollback(tx)
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", ErrTransactionCommitFailed, err)
+	}
+
+	hooks.run()
+
+	return nil
+}
+
+// AfterCommit implements the transaction.Notifier.AfterCommit() method.
+func (u *UnitOfWork) AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(afterCommitContextKey{}).(*afterCommitHooks)
+	if !ok {
+		fn()
+
+		return
+	}
+
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// InTransaction implements the transaction.Detector.InTransaction() method.
+func (u *UnitOfWork) InTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txContextKey{}).(*sql.Tx)
+
+	return ok
+}
+
+// rollback rolls transaction back. Failure is only logged to keep original error returned to caller.
+func rollback(tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		log.Printf("database transaction rollback failed: %s", err)
+	}
+}
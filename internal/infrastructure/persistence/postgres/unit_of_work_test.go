@@ -0,0 +1,322 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+var errTestHandlerFailed = errors.New("test handler failed")
+
+// recorder collects transaction events reported by fake database driver.
+type recorder struct {
+	mu         sync.Mutex
+	begins     int
+	commits    int
+	rollbacks  int
+	statements []string
+	args       [][]driver.NamedValue
+	inTx       []bool
+	execErr    error
+	// rows are returned by every query, result set is empty if there are none.
+	rows [][]driver.Value
+}
+
+func (r *recorder) record(event func(r *recorder)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event(r)
+}
+
+type fakeConnector struct {
+	recorder *recorder
+}
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{recorder: c.recorder}, nil
+}
+
+func (c fakeConnector) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+type fakeDriver struct{}
+
+func (d fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("not supported")
+}
+
+type fakeConn struct {
+	recorder *recorder
+	inTx     bool
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.inTx = true
+	c.recorder.record(func(r *recorder) { r.begins++ })
+
+	return fakeTx{conn: c}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.recorder.record(func(r *recorder) {
+		r.statements = append(r.statements, query)
+		r.args = append(r.args, args)
+		r.inTx = append(r.inTx, c.inTx)
+	})
+
+	if c.recorder.execErr != nil {
+		return nil, c.recorder.execErr
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	rows := new(fakeRows)
+
+	c.recorder.record(func(r *recorder) {
+		r.statements = append(r.statements, query)
+		r.inTx = append(r.inTx, c.inTx)
+		rows.rows = r.rows
+	})
+
+	return rows, nil
+}
+
+// fakeRows is the result set of recorder rows returned for every query.
+type fakeRows struct {
+	rows [][]driver.Value
+	next int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next == len(r.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[r.next])
+	r.next++
+
+	return nil
+}
+
+type fakeTx struct {
+	conn *fakeConn
+}
+
+func (t fakeTx) Commit() error {
+	t.conn.inTx = false
+	t.conn.recorder.record(func(r *recorder) { r.commits++ })
+
+	return nil
+}
+
+func (t fakeTx) Rollback() error {
+	t.conn.inTx = false
+	t.conn.recorder.record(func(r *recorder) { r.rollbacks++ })
+
+	return nil
+}
+
+func newFakeDatabase() (*sql.DB, *recorder) {
+	rec := &recorder{}
+
+	return sql.OpenDB(fakeConnector{recorder: rec}), rec
+}
+
+func newTestVerification(t *testing.T) *aggregate.Verification {
+	verification, err := aggregate.NewVerification(
+		uuid.New().String(),
+		aggregate.Identity,
+		"Fancy verification document description",
+	)
+	require.NoError(t, err)
+
+	return verification
+}
+
+func TestUnitOfWorkCommitSuccess(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	// act
+	err := NewUnitOfWork(db).Do(context.Background(), func(ctx context.Context) error {
+		return verificationRepository.Add(ctx, newTestVerification(t))
+	})
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, rec.begins)
+	assert.Equal(t, 1, rec.commits)
+	assert.Equal(t, 0, rec.rollbacks)
+	assert.Equal(t, []bool{true}, rec.inTx)
+}
+
+func TestUnitOfWorkRollbackOnError(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	// act
+	err := NewUnitOfWork(db).Do(context.Background(), func(ctx context.Context) error {
+		if err := verificationRepository.Add(ctx, newTestVerification(t)); err != nil {
+			return err
+		}
+
+		return errTestHandlerFailed
+	})
+
+	// assert
+	assert.ErrorIs(t, err, errTestHandlerFailed)
+	assert.Equal(t, 1, rec.begins)
+	assert.Equal(t, 0, rec.commits)
+	assert.Equal(t, 1, rec.rollbacks)
+	assert.Equal(t, []bool{true}, rec.inTx)
+}
+
+func TestUnitOfWorkRollbackOnPanic(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+
+	// act
+	assert.Panics(t, func() {
+		_ = NewUnitOfWork(db).Do(context.Background(), func(ctx context.Context) error {
+			panic(errTestHandlerFailed)
+		})
+	})
+
+	// assert
+	assert.Equal(t, 0, rec.commits)
+	assert.Equal(t, 1, rec.rollbacks)
+}
+
+func TestUnitOfWorkNestedCallsJoinTransaction(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	unitOfWork := NewUnitOfWork(db)
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	// act
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := verificationRepository.Add(ctx, newTestVerification(t)); err != nil {
+			return err
+		}
+
+		return unitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := verificationRepository.Add(ctx, newTestVerification(t)); err != nil {
+				return err
+			}
+
+			return errTestHandlerFailed
+		})
+	})
+
+	// assert
+	assert.ErrorIs(t, err, errTestHandlerFailed)
+	assert.Equal(t, 1, rec.begins)
+	assert.Equal(t, 0, rec.commits)
+	assert.Equal(t, 1, rec.rollbacks)
+	assert.Equal(t, []bool{true, true}, rec.inTx)
+}
+
+func TestRepositoryWithoutUnitOfWork(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	// act
+	err := verificationRepository.Add(context.Background(), newTestVerification(t))
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, 0, rec.begins)
+	assert.Equal(t, []bool{false}, rec.inTx)
+}
+
+func TestUnitOfWorkRunsAfterCommitFunctionsOnOutermostCommit(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	unitOfWork := NewUnitOfWork(db)
+
+	var committed []int
+
+	// act
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		unitOfWork.AfterCommit(ctx, func() { committed = append(committed, rec.commits) })
+
+		return unitOfWork.Do(ctx, func(ctx context.Context) error {
+			unitOfWork.AfterCommit(ctx, func() { committed = append(committed, rec.commits) })
+
+			return nil
+		})
+	})
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 1}, committed)
+}
+
+func TestUnitOfWorkSkipsAfterCommitFunctionsOnRollback(t *testing.T) {
+	// assign
+	db, _ := newFakeDatabase()
+	unitOfWork := NewUnitOfWork(db)
+
+	var called bool
+
+	// act
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		unitOfWork.AfterCommit(ctx, func() { called = true })
+
+		return errTestHandlerFailed
+	})
+
+	// assert
+	assert.ErrorIs(t, err, errTestHandlerFailed)
+	assert.False(t, called)
+}
+
+func TestUnitOfWorkRunsAfterCommitFunctionAtOnceOutsideTransaction(t *testing.T) {
+	// assign
+	db, _ := newFakeDatabase()
+
+	var called bool
+
+	// act
+	NewUnitOfWork(db).AfterCommit(context.Background(), func() { called = true })
+
+	// assert
+	assert.True(t, called)
+}
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/events"
+)
+
+// settledHistory limits verification history to entries of transactions finished before any running one started.
+// Entries are ordered by transaction id and id, so an entry of a transaction committed later than already read
+// entries could precede them; holding back entries of running transactions rules that out.
+// As a result events are delayed while any long transaction runs, e.g. bulk import.
+const settledHistory = "h.transaction_id < pg_snapshot_xmin(pg_current_snapshot())"
+
+// VerificationEventStore is a PostgreSQL events.Store implementation reading verification history as change sequence.
+type VerificationEventStore struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewVerificationEventStore initializes a PostgreSQL-based implementation of events.Store.
+func NewVerificationEventStore(db *sql.DB, dbTimeout time.Duration) *VerificationEventStore {
+	return &VerificationEventStore{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Head implements the events.Store.Head() method.
+func (s *VerificationEventStore) Head(ctx context.Context) (events.Position, error) {
+	query := `
+		SELECT h.transaction_id::text, h.id
+		FROM verification_history h
+		WHERE ` + settledHistory + `
+		ORDER BY h.transaction_id DESC, h.id DESC
+		LIMIT 1`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, s.dbTimeout)
+	defer cancel()
+
+	var position events.Position
+
+	err := conn(ctx, s.db).QueryRowContext(ctxTimeout, query).Scan(&position.Transaction, &position.Sequence)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return events.Position{}, err
+	}
+
+	return position, nil
+}
+
+// After implements the events.Store.After() method.
+func (s *VerificationEventStore) After(
+	ctx context.Context,
+	position events.Position,
+	filter events.Filter,
+	limit uint,
+) ([]events.Event, error) {
+	args := []any{strconv.FormatUint(position.Transaction, 10), position.Sequence}
+	conditions := []string{"(h.transaction_id, h.id) > ($1::xid8, $2)", settledHistory}
+
+	if filter.VerificationUUID != "" {
+		args = append(args, filter.VerificationUUID)
+		conditions = append(conditions, fmt.Sprintf("h.verification_uuid = $%d", len(args)))
+	}
+
+	if filter.Kind != "" {
+		args = append(args, filter.Kind)
+		conditions = append(conditions, fmt.Sprintf("v.kind = $%d", len(args)))
+	}
+
+	if len(filter.Statuses) > 0 {
+		args = append(args, pq.Array(filter.Statuses))
+		conditions = append(conditions, fmt.Sprintf("h.status = ANY($%d)", len(args)))
+	}
+
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+		SELECT h.transaction_id::text, h.id, h.verification_uuid, v.kind, h.status, h.reason, h.actor, h.occurred_at
+		FROM verification_history h
+		JOIN verifications v ON v.uuid = h.verification_uuid
+		WHERE %s
+		ORDER BY h.transaction_id, h.id
+		LIMIT $%d`, strings.Join(conditions, " AND "), len(args))
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, s.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, s.db).QueryContext(ctxTimeout, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []events.Event
+
+	for rows.Next() {
+		var event events.Event
+
+		err := rows.Scan(
+			&event.Position.Transaction,
+			&event.Position.Sequence,
+			&event.VerificationUUID,
+			&event.Kind,
+			&event.Status,
+			&event.Reason,
+			&event.Actor,
+			&event.OccurredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, event)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/events"
+)
+
+func TestVerificationEventStoreHeadOfEmptyHistory(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationEventStore := NewVerificationEventStore(db, time.Second)
+
+	// act
+	head, err := verificationEventStore.Head(context.Background())
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, events.Position{}, head)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(t, rec.statements[0], settledHistory)
+	assert.Contains(t, rec.statements[0], "ORDER BY h.transaction_id DESC, h.id DESC")
+}
+
+func TestVerificationEventStoreAfterQuery(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationEventStore := NewVerificationEventStore(db, time.Second)
+
+	// act
+	result, err := verificationEventStore.After(
+		context.Background(),
+		events.Position{Transaction: 7, Sequence: 3},
+		events.Filter{VerificationUUID: "1", Kind: "identity", Statuses: []string{"approved", "declined"}},
+		10,
+	)
+
+	// assert
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(
+		t,
+		rec.statements[0],
+		"WHERE (h.transaction_id, h.id) > ($1::xid8, $2) AND "+settledHistory+
+			" AND h.verification_uuid = $3 AND v.kind = $4 AND h.status = ANY($5)",
+	)
+	assert.Contains(t, rec.statements[0], "ORDER BY h.transaction_id, h.id\n\t\tLIMIT $6")
+}
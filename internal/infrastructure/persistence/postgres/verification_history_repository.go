@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+var ErrHistoryEntryPersistFailed = errors.New("error trying to persist verification history entry to database")
+
+// VerificationHistoryRepository is a PostgreSQL aggregate.HistoryRepository implementation.
+type VerificationHistoryRepository struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewVerificationHistoryRepository initializes a PostgreSQL-based implementation of aggregate.HistoryRepository.
+func NewVerificationHistoryRepository(db *sql.DB, dbTimeout time.Duration) *VerificationHistoryRepository {
+	return &VerificationHistoryRepository{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Add implements the aggregate.HistoryRepository.Add() method.
+func (r *VerificationHistoryRepository) Add(ctx context.Context, entry *aggregate.HistoryEntry) error {
+	const query = `
+		INSERT INTO verification_history (verification_uuid, status, reason, actor, occurred_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctxTimeout,
+		query,
+		entry.VerificationUUID,
+		entry.Status,
+		entry.Reason,
+		entry.Actor,
+		entry.OccurredAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrHistoryEntryPersistFailed, err)
+	}
+
+	return nil
+}
+
+// ListByVerification implements the aggregate.HistoryRepository.ListByVerification() method.
+func (r *VerificationHistoryRepository) ListByVerification(
+	ctx context.Context,
+	uuid aggregate.VerificationUUID,
+) ([]*aggregate.HistoryEntry, error) {
+	const query = `
+		SELECT verification_uuid, status, reason, actor, occurred_at
+		FROM verification_history
+		WHERE verification_uuid = $1
+		ORDER BY occurred_at, id`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, uuid.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*aggregate.HistoryEntry
+
+	for rows.Next() {
+		var entry aggregate.HistoryEntry
+
+		if err := rows.Scan(&entry.VerificationUUID, &entry.Status, &entry.Reason, &entry.Actor, &entry.OccurredAt); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, rows.Err()
+}
@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+var ErrVerificationImportFailed = errors.New("error trying to copy imported verifications to database")
+
+// VerificationImportRepository is a PostgreSQL importer.Repository implementation.
+// Batches are written with COPY along with history entries and read model views in a single transaction.
+type VerificationImportRepository struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+	views     *VerificationViewRepository
+}
+
+// NewVerificationImportRepository initializes a PostgreSQL-based implementation of importer.Repository.
+func NewVerificationImportRepository(
+	db *sql.DB,
+	dbTimeout time.Duration,
+	views *VerificationViewRepository,
+) *VerificationImportRepository {
+	return &VerificationImportRepository{
+		db:        db,
+		dbTimeout: dbTimeout,
+		views:     views,
+	}
+}
+
+// Existing implements the importer.Repository.Existing() method.
+func (r *VerificationImportRepository) Existing(ctx context.Context, uuids []string) (map[string]struct{}, error) {
+	const query = `SELECT uuid FROM verifications WHERE uuid = ANY($1)`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, pq.Array(uuids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	existing := make(map[string]struct{})
+
+	for rows.Next() {
+		var uuid string
+
+		if err := rows.Scan(&uuid); err != nil {
+			return nil, err
+		}
+
+		existing[uuid] = struct{}{}
+	}
+
+	return existing, rows.Err()
+}
+
+// AddBatch implements the importer.Repository.AddBatch() method.
+// Every verification gets creation history entry and, unless it is a draft, status entry at its decision date.
+// Dates are stored in UTC like in VerificationRepository.
+func (r *VerificationImportRepository) AddBatch(
+	ctx context.Context,
+	verifications []*aggregate.Verification,
+	actor string,
+) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	return NewUnitOfWork(r.db).Do(ctxTimeout, func(ctx context.Context) error {
+		tx := ctx.Value(txContextKey{}).(*sql.Tx)
+
+		uuids := make([]string, len(verifications))
+		verificationRows := make([][]any, len(verifications))
+		historyRows := make([][]any, 0, 2*len(verifications))
+
+		for i, verification := range verifications {
+			uuids[i] = verification.UUID().Value()
+			verificationRows[i] = []any{
+				verification.UUID().Value(),
+				verification.Kind().Value(),
+				verification.Description().Value(),
+				verification.DeclineReason().Value(),
+				verification.Status().Value(),
+				verification.CreatedAt().UTC(),
+				nullTime(verification.DecidedAt()),
+				verification.UpdatedAt().UTC(),
+			}
+			historyRows = append(historyRows, []any{
+				verification.UUID().Value(),
+				aggregate.Draft,
+				"",
+				actor,
+				verification.CreatedAt().UTC(),
+			})
+
+			if verification.Status().Value() == aggregate.Draft {
+				continue
+			}
+
+			occurredAt := verification.DecidedAt()
+			if occurredAt.IsZero() {
+				occurredAt = verification.CreatedAt()
+			}
+
+			historyRows = append(historyRows, []any{
+				verification.UUID().Value(),
+				verification.Status().Value(),
+				verification.DeclineReason().Value(),
+				actor,
+				occurredAt.UTC(),
+			})
+		}
+
+		if err := copyIn(ctx, tx, "verifications", []string{
+			"uuid", "kind", "description", "decline_reason", "status", "created_at", "decided_at", "updated_at",
+		}, verificationRows); err != nil {
+			return err
+		}
+
+		if err := copyIn(ctx, tx, "verification_history", []string{
+			"verification_uuid", "status", "reason", "actor", "occurred_at",
+		}, historyRows); err != nil {
+			return err
+		}
+
+		if _, err := r.views.Refresh(ctx, uuids); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// copyIn writes rows to table columns with COPY FROM STDIN.
+func copyIn(ctx context.Context, tx *sql.Tx, table string, columns []string, rows [][]any) error {
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(table, columns...))
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrVerificationImportFailed, err)
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return fmt.Errorf("%s: %w", ErrVerificationImportFailed, err)
+		}
+	}
+
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return fmt.Errorf("%s: %w", ErrVerificationImportFailed, err)
+	}
+
+	return nil
+}
+
+// nullTime converts zero time to NULL.
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
@@ -8,10 +8,14 @@ import (
 	"time"
 
 	"github.com/huandu/go-sqlbuilder"
+	"github.com/lib/pq"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres/model"
 )
 
+// uniqueViolationCode is the PostgreSQL unique_violation error code.
+const uniqueViolationCode = "23505"
+
 var (
 	ErrVerificationPersistFailed = errors.New("error trying to persist verification to database")
 	ErrVerificationNotFound      = errors.New("verification not found")
@@ -45,7 +49,11 @@ func (r *VerificationRepository) Add(ctx context.Context, verification *aggregat
 	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
 	defer cancel()
 
-	if _, err := r.db.ExecContext(ctxTimeout, query, args...); err != nil {
+	if _, err := conn(ctx, r.db).ExecContext(ctxTimeout, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: %s", aggregate.ErrVerificationAlreadyExists, verification.UUID().Value())
+		}
+
 		return fmt.Errorf("%s: %w", ErrVerificationPersistFailed, err)
 	}
 
@@ -68,7 +76,7 @@ func (r *VerificationRepository) Update(ctx context.Context, verification *aggre
 	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
 	defer cancel()
 
-	if _, err := r.db.ExecContext(ctxTimeout, query, args...); err != nil {
+	if _, err := conn(ctx, r.db).ExecContext(ctxTimeout, query, args...); err != nil {
 		return fmt.Errorf("%s: %w", ErrVerificationPersistFailed, err)
 	}
 
@@ -89,7 +97,7 @@ func (r *VerificationRepository) GetByUUID(ctx context.Context, uuid aggregate.V
 
 	var SQLVerification model.SQLVerification
 
-	err := r.db.QueryRowContext(ctxTimeout, query, args...).Scan(verificationSQLStruct.Addr(&SQLVerification)...)
+	err := conn(ctx, r.db).QueryRowContext(ctxTimeout, query, args...).Scan(verificationSQLStruct.Addr(&SQLVerification)...)
 
 	if err != nil {
 		switch {
@@ -102,3 +110,139 @@ func (r *VerificationRepository) GetByUUID(ctx context.Context, uuid aggregate.V
 
 	return model.ToDomainVerification(SQLVerification)
 }
+
+// verificationSortColumns maps aggregate.VerificationSort fields to verifications table columns.
+var verificationSortColumns = map[string]string{
+	aggregate.SortByCreatedAt: "created_at",
+	aggregate.SortByStatus:    "status",
+	aggregate.SortByKind:      "kind",
+}
+
+// List implements the aggregate.VerificationRepository.List() method.
+// Pages are keyset-based: rows are ordered by sort column and id, and the next page starts after cursor row.
+func (r *VerificationRepository) List(
+	ctx context.Context,
+	filter aggregate.VerificationFilter,
+) ([]*aggregate.Verification, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	var verifications []*aggregate.Verification
+
+	err := r.query(ctxTimeout, ctx, filter, func(verification *aggregate.Verification) error {
+		verifications = append(verifications, verification)
+
+		return nil
+	})
+
+	return verifications, err
+}
+
+// Stream implements the aggregate.VerificationRepository.Stream() method.
+// Rows are read from the database as fn consumes them. Database timeout is not applied,
+// so the stream lasts as long as ctx, e.g. until client of a large export disconnects.
+func (r *VerificationRepository) Stream(
+	ctx context.Context,
+	filter aggregate.VerificationFilter,
+	fn func(*aggregate.Verification) error,
+) error {
+	return r.query(ctx, ctx, filter, fn)
+}
+
+// query selects verifications matching filter with queryCtx and calls fn for each of them.
+// Transaction is taken from ctx which, unlike queryCtx, is not limited by timeout.
+func (r *VerificationRepository) query(
+	queryCtx context.Context,
+	ctx context.Context,
+	filter aggregate.VerificationFilter,
+	fn func(*aggregate.Verification) error,
+) error {
+	verificationSQLStruct := sqlbuilder.NewStruct(new(model.SQLVerification))
+
+	selectBuilder := verificationSQLStruct.SelectFromForTag(model.SQLVerificationTable, model.SQLVerificationGetTag)
+
+	if filter.Status != "" {
+		selectBuilder.Where(selectBuilder.Equal("status", filter.Status))
+	}
+
+	if filter.Kind != "" {
+		selectBuilder.Where(selectBuilder.Equal("kind", filter.Kind))
+	}
+
+	if filter.ExternalReference != "" {
+		selectBuilder.Where(selectBuilder.Equal("external_reference", filter.ExternalReference))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		selectBuilder.Where(selectBuilder.GreaterEqualThan("created_at", filter.CreatedFrom.UTC()))
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		selectBuilder.Where(selectBuilder.LessThan("created_at", filter.CreatedTo.UTC()))
+	}
+
+	column, ok := verificationSortColumns[filter.Sort.Field()]
+	if !ok {
+		column = verificationSortColumns[aggregate.SortByCreatedAt]
+	}
+
+	direction, operator := "ASC", ">"
+	if filter.Sort.Descending() {
+		direction, operator = "DESC", "<"
+	}
+
+	if filter.After != nil {
+		var value any = filter.After.Value
+		if column == verificationSortColumns[aggregate.SortByCreatedAt] {
+			value = filter.After.CreatedAt
+		}
+
+		selectBuilder.Where(fmt.Sprintf(
+			"(%s, id) %s (%s, %s)",
+			column,
+			operator,
+			selectBuilder.Var(value),
+			selectBuilder.Var(filter.After.ID),
+		))
+	}
+
+	selectBuilder.OrderBy(column+" "+direction, "id "+direction)
+
+	if filter.Limit > 0 {
+		selectBuilder.Limit(int(filter.Limit))
+	}
+
+	query, args := selectBuilder.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := conn(ctx, r.db).QueryContext(queryCtx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var SQLVerification model.SQLVerification
+
+		if err := rows.Scan(verificationSQLStruct.Addr(&SQLVerification)...); err != nil {
+			return err
+		}
+
+		verification, err := model.ToDomainVerification(SQLVerification)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(verification); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// isUniqueViolation reports whether err is caused by PostgreSQL unique constraint.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode
+}
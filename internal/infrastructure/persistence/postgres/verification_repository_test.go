@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+func TestVerificationRepositoryAddAlreadyExistsError(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	rec.execErr = &pq.Error{Code: uniqueViolationCode}
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	// act
+	err := verificationRepository.Add(context.Background(), newTestVerification(t))
+
+	// assert
+	assert.ErrorIs(t, err, aggregate.ErrVerificationAlreadyExists)
+	assert.NotContains(t, err.Error(), ErrVerificationPersistFailed.Error())
+}
+
+func TestVerificationRepositoryAddPersistFailedError(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	rec.execErr = &pq.Error{Code: "08006"}
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	// act
+	err := verificationRepository.Add(context.Background(), newTestVerification(t))
+
+	// assert
+	assert.Contains(t, err.Error(), ErrVerificationPersistFailed.Error())
+	assert.NotErrorIs(t, err, aggregate.ErrVerificationAlreadyExists)
+}
+
+func TestVerificationRepositoryAddStoresDatesInUTC(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	createdAt := time.Date(2023, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+	verification := newTestVerification(t)
+	verification.WithCreatedAt(createdAt)
+	verification.WithUpdatedAt(createdAt)
+
+	// act
+	err := verificationRepository.Add(context.Background(), verification)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, rec.args, 1)
+
+	var dates []time.Time
+
+	for _, arg := range rec.args[0] {
+		if date, ok := arg.Value.(time.Time); ok {
+			dates = append(dates, date)
+		}
+	}
+
+	expected := time.Date(2023, 3, 1, 11, 0, 0, 0, time.UTC)
+	assert.Equal(t, []time.Time{expected, expected}, dates)
+}
+
+func TestVerificationRepositoryListKeysetQuery(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	sort, err := aggregate.NewVerificationSort(aggregate.SortByStatus, true)
+	require.NoError(t, err)
+
+	filter := aggregate.VerificationFilter{
+		Kind:  aggregate.Identity,
+		Sort:  sort,
+		After: &aggregate.VerificationCursor{Value: aggregate.Draft, ID: 10},
+		Limit: 20,
+	}
+
+	// act
+	verifications, err := verificationRepository.List(context.Background(), filter)
+
+	// assert
+	require.NoError(t, err)
+	assert.Empty(t, verifications)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(t, rec.statements[0], "kind = $1")
+	assert.Contains(t, rec.statements[0], "(status, id) < ($2, $3)")
+	assert.Contains(t, rec.statements[0], "ORDER BY status DESC, id DESC")
+	assert.Contains(t, rec.statements[0], "LIMIT 20")
+}
+
+func TestVerificationRepositoryListByExternalReferenceQuery(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationRepository := NewVerificationRepository(db, time.Second)
+
+	sort, err := aggregate.NewVerificationSort(aggregate.SortByCreatedAt, false)
+	require.NoError(t, err)
+
+	filter := aggregate.VerificationFilter{
+		Status:            aggregate.Draft,
+		ExternalReference: "applicant-42",
+		Sort:              sort,
+		Limit:             20,
+	}
+
+	// act
+	verifications, err := verificationRepository.List(context.Background(), filter)
+
+	// assert
+	require.NoError(t, err)
+	assert.Empty(t, verifications)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(t, rec.statements[0], "status = $1")
+	assert.Contains(t, rec.statements[0], "external_reference = $2")
+}
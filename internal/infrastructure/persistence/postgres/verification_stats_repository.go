@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/stats"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+)
+
+// VerificationStatsRepository is a PostgreSQL stats.Repository implementation computing statistics from verifications table.
+type VerificationStatsRepository struct {
+	db        *sql.DB
+	dbTimeout time.Duration
+}
+
+// NewVerificationStatsRepository initializes a PostgreSQL-based implementation of stats.Repository.
+func NewVerificationStatsRepository(db *sql.DB, dbTimeout time.Duration) *VerificationStatsRepository {
+	return &VerificationStatsRepository{
+		db:        db,
+		dbTimeout: dbTimeout,
+	}
+}
+
+// Counts implements the stats.Repository.Counts() method.
+func (r *VerificationStatsRepository) Counts(ctx context.Context, period stats.Period) ([]stats.Count, error) {
+	const query = `
+		SELECT status, kind, COUNT(*)
+		FROM verifications
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY status, kind`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, period.From.UTC(), period.To.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []stats.Count
+
+	for rows.Next() {
+		var count stats.Count
+
+		if err := rows.Scan(&count.Status, &count.Kind, &count.Total); err != nil {
+			return nil, err
+		}
+
+		counts = append(counts, count)
+	}
+
+	return counts, rows.Err()
+}
+
+// DecisionTime implements the stats.Repository.DecisionTime() method.
+// Verifications decided before decision date was tracked are not taken into account.
+func (r *VerificationStatsRepository) DecisionTime(ctx context.Context, period stats.Period) (stats.DecisionTime, error) {
+	const query = `
+		SELECT COUNT(*),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM decided_at - created_at)), 0),
+			COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM decided_at - created_at)), 0)
+		FROM verifications
+		WHERE created_at >= $1 AND created_at < $2 AND decided_at IS NOT NULL`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	var (
+		decisionTime stats.DecisionTime
+		p50, p90     float64
+	)
+
+	err := conn(ctx, r.db).
+		QueryRowContext(ctxTimeout, query, period.From.UTC(), period.To.UTC()).
+		Scan(&decisionTime.Decided, &p50, &p90)
+	if err != nil {
+		return stats.DecisionTime{}, err
+	}
+
+	decisionTime.P50 = time.Duration(p50 * float64(time.Second))
+	decisionTime.P90 = time.Duration(p90 * float64(time.Second))
+
+	return decisionTime, nil
+}
+
+// DailyBuckets implements the stats.Repository.DailyBuckets() method.
+// Every day of period has a bucket, days without activity have zero counts.
+func (r *VerificationStatsRepository) DailyBuckets(ctx context.Context, period stats.Period) ([]stats.DailyBucket, error) {
+	const query = `
+		WITH days AS (
+			SELECT generate_series(date_trunc('day', $1::timestamp), $2::timestamp - INTERVAL '1 microsecond', INTERVAL '1 day') AS day
+		), created AS (
+			SELECT date_trunc('day', created_at) AS day, COUNT(*) AS total
+			FROM verifications
+			WHERE created_at >= $1 AND created_at < $2
+			GROUP BY 1
+		), decided AS (
+			SELECT date_trunc('day', decided_at) AS day,
+				COUNT(*) FILTER (WHERE status = $3) AS approved,
+				COUNT(*) FILTER (WHERE status = $4) AS declined
+			FROM verifications
+			WHERE decided_at >= $1 AND decided_at < $2
+			GROUP BY 1
+		)
+		SELECT days.day, COALESCE(created.total, 0), COALESCE(decided.approved, 0), COALESCE(decided.declined, 0)
+		FROM days
+		LEFT JOIN created USING (day)
+		LEFT JOIN decided USING (day)
+		ORDER BY days.day`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(
+		ctxTimeout,
+		query,
+		period.From.UTC(),
+		period.To.UTC(),
+		aggregate.Approved,
+		aggregate.Declined,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []stats.DailyBucket
+
+	for rows.Next() {
+		var bucket stats.DailyBucket
+
+		if err := rows.Scan(&bucket.Day, &bucket.Created, &bucket.Approved, &bucket.Declined); err != nil {
+			return nil, err
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, rows.Err()
+}
@@ -0,0 +1,295 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/lib/pq"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+)
+
+const (
+	verificationViewColumns = "uuid, kind, description, status, decline_reason, created_at, projected_at"
+	// searchVectorExpression builds search_vector of Save query from description ($3) and decline reason ($5).
+	searchVectorExpression = "to_tsvector($8::regconfig, $3 || ' ' || $5)"
+)
+
+// verificationViewProjection selects verification_views rows of verifications projected at $1
+// with search_vector built with $2 text search configuration.
+const verificationViewProjection = `SELECT uuid, kind, description, status, COALESCE(decline_reason, ''), created_at, $1,
+			to_tsvector($2::regconfig, description || ' ' || COALESCE(decline_reason, ''))
+		FROM verifications`
+
+var ErrVerificationViewPersistFailed = errors.New("error trying to persist verification view to database")
+
+// Search snippets mark matched words with private use characters, so stored text can be HTML-escaped
+// before the marks are replaced with <mark> tags.
+const (
+	searchMatchStart = "\uE000"
+	searchMatchStop  = "\uE001"
+)
+
+// searchHeadlineOptions configures ts_headline snippets of search results.
+const searchHeadlineOptions = "StartSel=" + searchMatchStart + ", StopSel=" + searchMatchStop +
+	", MaxWords=35, MinWords=15, MaxFragments=2"
+
+// snippetHighlighter replaces search match marks of escaped snippet with <mark> tags.
+var snippetHighlighter = strings.NewReplacer(searchMatchStart, "<mark>", searchMatchStop, "</mark>")
+
+// highlightSnippet HTML-escapes ts_headline snippet and wraps matched words in <mark> tags.
+func highlightSnippet(snippet string) string {
+	return snippetHighlighter.Replace(html.EscapeString(snippet))
+}
+
+// VerificationViewRepository is a PostgreSQL readmodel.Repository implementation.
+// Views keep search_vector of description and decline reason built with searchLanguage text search configuration.
+type VerificationViewRepository struct {
+	db             *sql.DB
+	dbTimeout      time.Duration
+	searchLanguage string
+}
+
+// NewVerificationViewRepository initializes a PostgreSQL-based implementation of readmodel.Repository.
+// Search language is a PostgreSQL text search configuration name, e.g. "english" or "simple".
+func NewVerificationViewRepository(db *sql.DB, dbTimeout time.Duration, searchLanguage string) *VerificationViewRepository {
+	return &VerificationViewRepository{
+		db:             db,
+		dbTimeout:      dbTimeout,
+		searchLanguage: searchLanguage,
+	}
+}
+
+// Save implements the readmodel.Repository.Save() method.
+func (r *VerificationViewRepository) Save(ctx context.Context, view *readmodel.VerificationView) error {
+	const query = `
+		INSERT INTO verification_views (` + verificationViewColumns + `, search_vector)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, ` + searchVectorExpression + `)
+		ON CONFLICT (uuid) DO UPDATE SET
+			kind = EXCLUDED.kind,
+			description = EXCLUDED.description,
+			status = EXCLUDED.status,
+			decline_reason = EXCLUDED.decline_reason,
+			projected_at = EXCLUDED.projected_at,
+			search_vector = EXCLUDED.search_vector`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctxTimeout,
+		query,
+		view.UUID,
+		view.Kind,
+		view.Description,
+		view.Status,
+		view.DeclineReason,
+		view.CreatedAt.UTC(),
+		view.ProjectedAt.UTC(),
+		r.searchLanguage,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrVerificationViewPersistFailed, err)
+	}
+
+	return nil
+}
+
+// List implements the readmodel.Repository.List() method.
+func (r *VerificationViewRepository) List(ctx context.Context, filter readmodel.Filter) ([]*readmodel.VerificationView, error) {
+	selectBuilder := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	selectBuilder.Select(verificationViewColumns).From("verification_views")
+	if filter.Kind != "" {
+		selectBuilder.Where(selectBuilder.Equal("kind", filter.Kind))
+	}
+
+	if filter.Status != "" {
+		selectBuilder.Where(selectBuilder.Equal("status", filter.Status))
+	}
+
+	selectBuilder.OrderBy("created_at DESC", "uuid DESC")
+
+	if filter.Limit > 0 {
+		selectBuilder.Limit(int(filter.Limit))
+	}
+
+	if filter.Offset > 0 {
+		selectBuilder.Offset(int(filter.Offset))
+	}
+
+	query, args := selectBuilder.Build()
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var views []*readmodel.VerificationView
+
+	for rows.Next() {
+		var view readmodel.VerificationView
+
+		err := rows.Scan(
+			&view.UUID,
+			&view.Kind,
+			&view.Description,
+			&view.Status,
+			&view.DeclineReason,
+			&view.CreatedAt,
+			&view.ProjectedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		views = append(views, &view)
+	}
+
+	return views, rows.Err()
+}
+
+// CountByStatus implements the readmodel.Repository.CountByStatus() method.
+func (r *VerificationViewRepository) CountByStatus(ctx context.Context, filter readmodel.Filter) (map[string]uint, error) {
+	selectBuilder := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	selectBuilder.Select("status", "COUNT(*)").From("verification_views")
+	if filter.Kind != "" {
+		selectBuilder.Where(selectBuilder.Equal("kind", filter.Kind))
+	}
+
+	selectBuilder.GroupBy("status")
+
+	query, args := selectBuilder.Build()
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]uint)
+
+	for rows.Next() {
+		var (
+			status string
+			count  uint
+		)
+
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+
+		counts[status] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// Search implements the readmodel.Repository.Search() method.
+// Text is parsed with websearch_to_tsquery, so it supports quoted phrases, "or" and "-" exclusions.
+func (r *VerificationViewRepository) Search(ctx context.Context, text string, limit uint) ([]*readmodel.SearchResult, error) {
+	const query = `
+		SELECT ` + verificationViewColumns + `,
+			ts_rank_cd(search_vector, query) AS rank,
+			ts_headline($1::regconfig, description || ' ' || decline_reason, query, $3) AS snippet
+		FROM verification_views, websearch_to_tsquery($1::regconfig, $2) AS query
+		WHERE search_vector @@ query
+		ORDER BY rank DESC, created_at DESC, uuid DESC
+		LIMIT $4`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	rows, err := conn(ctx, r.db).QueryContext(ctxTimeout, query, r.searchLanguage, text, searchHeadlineOptions, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*readmodel.SearchResult
+
+	for rows.Next() {
+		var (
+			view   readmodel.VerificationView
+			result = readmodel.SearchResult{View: &view}
+		)
+
+		err := rows.Scan(
+			&view.UUID,
+			&view.Kind,
+			&view.Description,
+			&view.Status,
+			&view.DeclineReason,
+			&view.CreatedAt,
+			&view.ProjectedAt,
+			&result.Rank,
+			&result.Snippet,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Snippet = highlightSnippet(result.Snippet)
+		results = append(results, &result)
+	}
+
+	return results, rows.Err()
+}
+
+// Rebuild recomputes all verification views from the verifications table and returns the number of views.
+// It should run inside transaction.UnitOfWork, so readers never see the empty read model.
+func (r *VerificationViewRepository) Rebuild(ctx context.Context) (int64, error) {
+	const query = `
+		INSERT INTO verification_views (` + verificationViewColumns + `, search_vector)
+		` + verificationViewProjection
+
+	executor := conn(ctx, r.db)
+
+	if _, err := executor.ExecContext(ctx, "TRUNCATE verification_views"); err != nil {
+		return 0, fmt.Errorf("%s: %w", ErrVerificationViewPersistFailed, err)
+	}
+
+	result, err := executor.ExecContext(ctx, query, time.Now().UTC(), r.searchLanguage)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", ErrVerificationViewPersistFailed, err)
+	}
+
+	return result.RowsAffected()
+}
+
+// Refresh projects verifications of uuids straight from verifications table, replacing their existing views.
+// It is used by bulk writes bypassing domain events, e.g. import. Returns the number of projected views.
+func (r *VerificationViewRepository) Refresh(ctx context.Context, uuids []string) (int64, error) {
+	const query = `
+		INSERT INTO verification_views (` + verificationViewColumns + `, search_vector)
+		` + verificationViewProjection + `
+		WHERE uuid = ANY($3)
+		ON CONFLICT (uuid) DO UPDATE SET
+			kind = EXCLUDED.kind,
+			description = EXCLUDED.description,
+			status = EXCLUDED.status,
+			decline_reason = EXCLUDED.decline_reason,
+			projected_at = EXCLUDED.projected_at,
+			search_vector = EXCLUDED.search_vector`
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, r.dbTimeout)
+	defer cancel()
+
+	result, err := conn(ctx, r.db).ExecContext(ctxTimeout, query, time.Now().UTC(), r.searchLanguage, pq.Array(uuids))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", ErrVerificationViewPersistFailed, err)
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+)
+
+func TestVerificationViewRepositorySaveUpdatesSearchVector(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationViewRepository := NewVerificationViewRepository(db, time.Second, "simple")
+
+	// act
+	err := verificationViewRepository.Save(context.Background(), &readmodel.VerificationView{UUID: "1"})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(t, rec.statements[0], "to_tsvector($8::regconfig, $3 || ' ' || $5)")
+	assert.Contains(t, rec.statements[0], "search_vector = EXCLUDED.search_vector")
+}
+
+func TestVerificationViewRepositorySearchQuery(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationViewRepository := NewVerificationViewRepository(db, time.Second, "simple")
+
+	// act
+	results, err := verificationViewRepository.Search(context.Background(), "passport", 10)
+
+	// assert
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(t, rec.statements[0], "websearch_to_tsquery($1::regconfig, $2)")
+	assert.Contains(t, rec.statements[0], "ORDER BY rank DESC")
+}
+
+func TestHighlightSnippetEscapesText(t *testing.T) {
+	// act
+	snippet := highlightSnippet("<img src=x onerror=alert(1)> blurry " + searchMatchStart + "passport" + searchMatchStop + " & photo")
+
+	// assert
+	assert.Equal(t, "&lt;img src=x onerror=alert(1)&gt; blurry <mark>passport</mark> &amp; photo", snippet)
+}
+
+func TestVerificationViewRepositoryRefreshQuery(t *testing.T) {
+	// assign
+	db, rec := newFakeDatabase()
+	verificationViewRepository := NewVerificationViewRepository(db, time.Second, "simple")
+
+	// act
+	_, err := verificationViewRepository.Refresh(context.Background(), []string{"1"})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, rec.statements, 1)
+	assert.Contains(t, rec.statements[0], "FROM verifications\n\t\tWHERE uuid = ANY($3)")
+	assert.Contains(t, rec.statements[0], "ON CONFLICT (uuid) DO UPDATE")
+}
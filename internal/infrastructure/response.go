@@ -0,0 +1,11 @@
+package infrastructure
+
+// JobAcceptedResponse represents response structure for request handled in background.
+type JobAcceptedResponse struct {
+	JobID string `json:"jobId"`
+}
+
+// NewJobAcceptedResponse instantiate the JobAcceptedResponse.
+func NewJobAcceptedResponse(jobID string) JobAcceptedResponse {
+	return JobAcceptedResponse{JobID: jobID}
+}
@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+)
+
+// Poller periodically dispatches due scheduled commands in background.
+// Several application instances may poll the same storage, due entries are locked while they run.
+type Poller struct {
+	scheduler *scheduler.Scheduler
+	batchSize uint
+	stop      chan struct{}
+	done      chan struct{}
+	stopOnce  sync.Once
+}
+
+// NewPoller creates a new Poller and starts dispatching at most batchSize due commands every interval.
+func NewPoller(scheduler *scheduler.Scheduler, interval time.Duration, batchSize uint) *Poller {
+	p := &Poller{
+		scheduler: scheduler,
+		batchSize: batchSize,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	go p.poll(interval)
+
+	return p
+}
+
+// Shutdown stops polling and waits until the current round is finished.
+func (p *Poller) Shutdown(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.stop) })
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// poll dispatches due commands until Shutdown is called.
+func (p *Poller) poll(interval time.Duration) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case now := <-ticker.C:
+			if _, err := p.scheduler.RunDue(context.Background(), now, p.batchSize); err != nil {
+				log.Printf("scheduled commands dispatching failed: %s", err)
+			}
+		}
+	}
+}
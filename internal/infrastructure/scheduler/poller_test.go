@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+func TestPollerRunsDueCommandsUntilShutdown(t *testing.T) {
+	// assign
+	polled := make(chan struct{}, 1)
+
+	scheduledCommandRepositoryMock := new(persistence.ScheduledCommandRepository)
+	scheduledCommandRepositoryMock.On("RunNext", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) {
+			select {
+			case polled <- struct{}{}:
+			default:
+			}
+		}).
+		Return(false, nil)
+
+	poller := NewPoller(
+		scheduler.NewScheduler(scheduledCommandRepositoryMock, bus.NewCommandCodec(), nil),
+		time.Millisecond,
+		10,
+	)
+
+	// act
+	select {
+	case <-polled:
+	case <-time.After(time.Second):
+		t.Fatal("scheduled commands are not polled")
+	}
+
+	err := poller.Shutdown(context.Background())
+
+	// assert
+	assert.NoError(t, err)
+}
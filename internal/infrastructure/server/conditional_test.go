@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+	appMiddleware "github.com/vitalii-tkachuk/verification-service/internal/infrastructure/server/middleware"
+)
+
+var testUpdatedAt = time.Date(2026, time.March, 2, 12, 30, 45, 0, time.UTC)
+
+// verificationQueryBus answers every query with verification.
+type verificationQueryBus struct {
+	verification *aggregate.Verification
+}
+
+func (b verificationQueryBus) Ask(context.Context, bus.Query) (any, error) {
+	return b.verification, nil
+}
+
+func (b verificationQueryBus) Register(bus.QueryType, bus.QueryHandler) error { return nil }
+
+// newVerificationRouter creates router whose queries return verification updated at updatedAt.
+func newVerificationRouter(t *testing.T, updatedAt time.Time) http.Handler {
+	verification, err := aggregate.NewVerification(testVerificationUUID, aggregate.Identity, "Selfie with passport")
+	require.NoError(t, err)
+
+	verification.WithCreatedAt(updatedAt.Add(-time.Hour))
+	verification.WithUpdatedAt(updatedAt)
+
+	return newApplicationRouter(failingCommandBus{}, verificationQueryBus{verification: verification})
+}
+
+// getVerification sends get verification request with headers to router.
+func getVerification(router http.Handler, headers map[string]string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, "/v1/verifications/"+testVerificationUUID, nil)
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	return recorder
+}
+
+func TestGetVerificationIsTaggedWithValidators(t *testing.T) {
+	// act
+	response := getVerification(newVerificationRouter(t, testUpdatedAt), nil)
+
+	// assert
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, infrastructure.ETag(response.Body.Bytes()), response.Header().Get(infrastructure.ETagHeader))
+	assert.Equal(t, "Mon, 02 Mar 2026 12:30:45 GMT", response.Header().Get(infrastructure.LastModifiedHeader))
+	assert.Equal(t, appMiddleware.CacheControlRevalidate, response.Header().Get(appMiddleware.CacheControlHeader))
+	assert.Contains(t, response.Body.String(), `"updatedAt":"2026-03-02T12:30:45Z"`)
+}
+
+func TestGetVerificationETagChangesWithContent(t *testing.T) {
+	// act
+	first := getVerification(newVerificationRouter(t, testUpdatedAt), nil)
+	second := getVerification(newVerificationRouter(t, testUpdatedAt.Add(time.Minute)), nil)
+
+	// assert
+	assert.NotEqual(t, first.Header().Get(infrastructure.ETagHeader), second.Header().Get(infrastructure.ETagHeader))
+}
+
+func TestGetVerificationConditionalRequests(t *testing.T) {
+	router := newVerificationRouter(t, testUpdatedAt)
+	etag := getVerification(router, nil).Header().Get(infrastructure.ETagHeader)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		status  int
+	}{
+		{
+			name:    "matching entity tag",
+			headers: map[string]string{infrastructure.IfNoneMatchHeader: etag},
+			status:  http.StatusNotModified,
+		},
+		{
+			name:    "entity tag in list",
+			headers: map[string]string{infrastructure.IfNoneMatchHeader: `"stale", ` + etag},
+			status:  http.StatusNotModified,
+		},
+		{
+			name:    "weak entity tag",
+			headers: map[string]string{infrastructure.IfNoneMatchHeader: "W/" + etag},
+			status:  http.StatusNotModified,
+		},
+		{
+			name:    "any entity tag",
+			headers: map[string]string{infrastructure.IfNoneMatchHeader: "*"},
+			status:  http.StatusNotModified,
+		},
+		{
+			name:    "stale entity tag",
+			headers: map[string]string{infrastructure.IfNoneMatchHeader: `"stale"`},
+			status:  http.StatusOK,
+		},
+		{
+			name:    "not modified since",
+			headers: map[string]string{infrastructure.IfModifiedSinceHeader: "Mon, 02 Mar 2026 12:30:45 GMT"},
+			status:  http.StatusNotModified,
+		},
+		{
+			name:    "modified since",
+			headers: map[string]string{infrastructure.IfModifiedSinceHeader: "Mon, 02 Mar 2026 12:30:44 GMT"},
+			status:  http.StatusOK,
+		},
+		{
+			name:    "invalid modification date",
+			headers: map[string]string{infrastructure.IfModifiedSinceHeader: "yesterday"},
+			status:  http.StatusOK,
+		},
+		{
+			name: "entity tag takes precedence over modification date",
+			headers: map[string]string{
+				infrastructure.IfNoneMatchHeader:     `"stale"`,
+				infrastructure.IfModifiedSinceHeader: "Mon, 02 Mar 2026 12:30:45 GMT",
+			},
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// act
+			response := getVerification(router, tt.headers)
+
+			// assert
+			assert.Equal(t, tt.status, response.Code)
+			assert.Equal(t, etag, response.Header().Get(infrastructure.ETagHeader))
+			assert.Equal(t, appMiddleware.CacheControlRevalidate, response.Header().Get(appMiddleware.CacheControlHeader))
+
+			if tt.status == http.StatusNotModified {
+				assert.Empty(t, response.Body.String())
+			}
+		})
+	}
+}
+
+func TestRoutesCacheControl(t *testing.T) {
+	srv := newTestServer(errTestDatabase)
+
+	tests := []struct {
+		route routeRequest
+		value string
+	}{
+		{route: routeRequest{method: http.MethodGet, path: "/v1/verifications"}, value: appMiddleware.CacheControlRevalidate},
+		{route: routeRequest{method: http.MethodGet, path: "/v1/verifications/" + testVerificationUUID + "/timeline"}, value: appMiddleware.CacheControlRevalidate},
+		{route: routeRequest{method: http.MethodGet, path: "/v1/verifications/export"}, value: appMiddleware.CacheControlNoStore},
+		{route: routeRequest{method: http.MethodPost, path: "/v1/verifications", body: createBody}, value: appMiddleware.CacheControlNoStore},
+		{route: routeRequest{method: http.MethodPatch, path: "/v1/verifications/" + testVerificationUUID + "/approve"}, value: appMiddleware.CacheControlNoStore},
+		{route: routeRequest{method: http.MethodGet, path: "/v1/dashboard/verifications"}, value: appMiddleware.CacheControlShortLived},
+		{route: routeRequest{method: http.MethodGet, path: "/v1/stats/verifications"}, value: appMiddleware.CacheControlShortLived},
+		{route: routeRequest{method: http.MethodGet, path: "/v1/jobs/1"}, value: appMiddleware.CacheControlNoStore},
+		{route: routeRequest{method: http.MethodGet, path: "/v1/admin/dead-letters", internal: true}, value: appMiddleware.CacheControlNoStore},
+		{route: routeRequest{method: http.MethodGet, path: "/verifications/" + testVerificationUUID}, value: appMiddleware.CacheControlRevalidate},
+	}
+
+	for _, tt := range tests {
+		// act
+		response := serve(routerFor(srv, tt.route), tt.route)
+
+		// assert
+		assert.Equal(t, tt.value, response.Header().Get(appMiddleware.CacheControlHeader), "%s %s", tt.route.method, tt.route.path)
+	}
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/events"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	infrastructureBus "github.com/vitalii-tkachuk/verification-service/internal/infrastructure/bus"
+	appMiddleware "github.com/vitalii-tkachuk/verification-service/internal/infrastructure/server/middleware"
+	"github.com/vitalii-tkachuk/verification-service/test/mocks/persistence"
+)
+
+var testCreatedEvent = events.Event{
+	Position:         events.Position{Transaction: 7, Sequence: 3},
+	VerificationUUID: testVerificationUUID,
+	Kind:             aggregate.Identity,
+	Status:           aggregate.Draft,
+	Actor:            "agent@example.com",
+	OccurredAt:       time.Date(2026, time.March, 2, 12, 30, 45, 0, time.UTC),
+}
+
+// newEventsServer starts public and internal test servers streaming testCreatedEvent
+// from history of test verification.
+func newEventsServer(t *testing.T) (*httptest.Server, *httptest.Server, *events.Broker) {
+	verification, err := aggregate.NewVerification(testVerificationUUID, aggregate.Identity, "Selfie with passport")
+	require.NoError(t, err)
+
+	verificationRepositoryMock := new(persistence.VerificationRepository)
+	verificationRepositoryMock.On("GetByUUID", mock.Anything, verification.UUID()).Return(verification, nil)
+
+	eventStoreMock := new(persistence.VerificationEventStore)
+	eventStoreMock.On("Head", mock.Anything).Return(testCreatedEvent.Position, nil)
+	eventStoreMock.
+		On("After", mock.Anything, events.Position{}, events.Filter{VerificationUUID: testVerificationUUID}, uint(10)).
+		Return([]events.Event{testCreatedEvent}, nil)
+
+	broker := events.NewBroker(eventStoreMock, events.Options{
+		PollInterval:      time.Hour,
+		HeartbeatInterval: time.Hour,
+		BatchSize:         10,
+		BufferSize:        10,
+	})
+	require.NoError(t, broker.Start(context.Background()))
+
+	queryBus := infrastructureBus.NewQueryBus()
+	require.NoError(t, bus.RegisterQueryHandler[query.StreamVerificationEventsQuery, uint](
+		queryBus,
+		query.NewStreamVerificationEventsQueryHandler(verificationRepositoryMock, broker),
+	))
+
+	applicationServer := newApplicationServer(failingCommandBus{}, queryBus)
+	srv := httptest.NewServer(applicationServer.router)
+	internalSrv := httptest.NewServer(applicationServer.internalRouter)
+	t.Cleanup(func() {
+		broker.Close()
+		srv.Close()
+		internalSrv.Close()
+	})
+
+	return srv, internalSrv, broker
+}
+
+// readMessage reads event stream message lines up to the blank line ending it.
+func readMessage(t *testing.T, reader *bufio.Reader) string {
+	var lines []string
+
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		if line == "\n" {
+			return strings.Join(lines, "")
+		}
+
+		lines = append(lines, line)
+	}
+}
+
+func TestVerificationEventsStreamReplaysHistory(t *testing.T) {
+	// assign
+	srv, _, _ := newEventsServer(t)
+
+	// act
+	response, err := http.Get(srv.URL + "/v1/verifications/" + testVerificationUUID + "/events")
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	message := readMessage(t, bufio.NewReader(response.Body))
+
+	// assert
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+	assert.Equal(t, appMiddleware.CacheControlNoStore, response.Header.Get(appMiddleware.CacheControlHeader))
+	assert.Equal(
+		t,
+		"id: 7-3\nevent: status_changed\n"+
+			`data: {"uuid":"`+testVerificationUUID+`","kind":"identity","status":"draft","actor":"agent@example.com",`+
+			`"occurredAt":"2026-03-02T12:30:45Z"}`+"\n",
+		message,
+	)
+}
+
+func TestEventsStreamEndsOnBrokerClose(t *testing.T) {
+	// assign
+	srv, _, broker := newEventsServer(t)
+
+	response, err := http.Get(srv.URL + "/v1/verifications/" + testVerificationUUID + "/events")
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	reader := bufio.NewReader(response.Body)
+	readMessage(t, reader)
+
+	// act
+	broker.Close()
+	rest, err := io.ReadAll(reader)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Empty(t, rest)
+}
+
+func TestEventsStreamRejectsInvalidLastEventID(t *testing.T) {
+	// assign
+	_, internalSrv, _ := newEventsServer(t)
+
+	request, err := http.NewRequest(http.MethodGet, internalSrv.URL+"/v1/events", nil)
+	require.NoError(t, err)
+	request.Header.Set("Last-Event-ID", "last")
+
+	// act
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	// assert
+	assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+	assert.Contains(t, string(body), "lastEventId")
+}
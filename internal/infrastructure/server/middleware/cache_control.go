@@ -0,0 +1,26 @@
+package middleware
+
+import "net/http"
+
+const (
+	CacheControlHeader = "Cache-Control"
+	// CacheControlRevalidate lets clients keep response but revalidate it before every reuse.
+	// Shared caches must not store it because verifications are customer data.
+	CacheControlRevalidate = "private, no-cache"
+	// CacheControlShortLived lets clients reuse aggregated reports for a short time without revalidation.
+	CacheControlShortLived = "private, max-age=30"
+	// CacheControlNoStore forbids storing response, used for state changes and fast-changing resources.
+	CacheControlNoStore = "no-store"
+)
+
+// CacheControl returns middleware setting "Cache-Control" header of every response to value.
+// Handlers may override it before writing response.
+func CacheControl(value string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(CacheControlHeader, value)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	DeprecationHeader = "Deprecation"
+	SunsetHeader      = "Sunset"
+	LinkHeader        = "Link"
+)
+
+// Deprecation represents middleware marking routes deprecated since deprecatedAt (RFC 9745)
+// and removed at sunset (RFC 8594), linking every response to the same resource under successorPrefix.
+type Deprecation struct {
+	deprecatedAt    time.Time
+	sunset          time.Time
+	successorPrefix string
+}
+
+// NewDeprecation creates a new Deprecation middleware. Zero sunset omits Sunset header.
+func NewDeprecation(deprecatedAt, sunset time.Time, successorPrefix string) *Deprecation {
+	return &Deprecation{
+		deprecatedAt:    deprecatedAt,
+		sunset:          sunset,
+		successorPrefix: successorPrefix,
+	}
+}
+
+// Handler implements chi middleware interface.
+func (m *Deprecation) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(DeprecationHeader, fmt.Sprintf("@%d", m.deprecatedAt.Unix()))
+
+		if !m.sunset.IsZero() {
+			w.Header().Set(SunsetHeader, m.sunset.UTC().Format(http.TimeFormat))
+		}
+
+		w.Header().Add(LinkHeader, fmt.Sprintf(`<%s%s>; rel="successor-version"`, m.successorPrefix, r.URL.Path))
+
+		next.ServeHTTP(w, r)
+	})
+}
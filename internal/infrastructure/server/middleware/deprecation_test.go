@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationWithoutSunset(t *testing.T) {
+	// assign
+	next := &countingHandler{status: http.StatusOK}
+	handler := NewDeprecation(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), time.Time{}, "/v1").Handler(next)
+	response := httptest.NewRecorder()
+
+	// act
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/verifications", nil))
+
+	// assert
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, "@1793491200", response.Header().Get(DeprecationHeader))
+	assert.Empty(t, response.Header().Get(SunsetHeader))
+	assert.Equal(t, `</v1/verifications>; rel="successor-version"`, response.Header().Get(LinkHeader))
+}
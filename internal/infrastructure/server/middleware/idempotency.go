@@ -0,0 +1,204 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/idempotency"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyKeyMaxLength   = 255
+	idempotencyMaxBodyInBytes = 1048576
+)
+
+var (
+	ErrIdempotencyKeyTooLong    = infrastructure.NewCodedError("idempotency_key_too_long", "idempotency key must not be longer than 255 characters")
+	ErrIdempotencyKeyReused     = infrastructure.NewCodedError("idempotency_key_reused", "idempotency key is already used for another request")
+	ErrIdempotencyKeyInProgress = infrastructure.NewCodedError("idempotency_key_in_progress", "request with the same idempotency key is still in progress")
+	ErrIdempotencyRequestBody   = infrastructure.NewCodedError("idempotency_request_body_unreadable", "request body cannot be read")
+)
+
+// Idempotency represents middleware replaying stored response for repeated mutating requests with Idempotency-Key header.
+type Idempotency struct {
+	store       idempotency.Store
+	ttl         time.Duration
+	lockTimeout time.Duration
+}
+
+// NewIdempotency creates a new Idempotency middleware. Keys expire after ttl. Request in progress longer than
+// lockTimeout is considered abandoned, so its retry is handled again instead of being rejected.
+func NewIdempotency(store idempotency.Store, ttl, lockTimeout time.Duration) *Idempotency {
+	return &Idempotency{
+		store:       store,
+		ttl:         ttl,
+		lockTimeout: lockTimeout,
+	}
+}
+
+// Handler implements chi middleware interface.
+func (m *Idempotency) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || !isMutating(r.Method) {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if len(key) > idempotencyKeyMaxLength {
+			writeError(w, r, http.StatusBadRequest, ErrIdempotencyKeyTooLong)
+
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, idempotencyMaxBodyInBytes))
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, ErrIdempotencyRequestBody)
+
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		idempotencyKey := idempotency.NewKey(key, fingerprint(r, body), m.ttl, m.lockTimeout)
+
+		reserved, err := m.store.Reserve(r.Context(), idempotencyKey)
+		if err != nil {
+			log.Printf("idempotency key %s reservation failed: %s", key, err)
+			writeError(w, r, http.StatusInternalServerError, infrastructure.ErrInternal)
+
+			return
+		}
+
+		if !reserved {
+			m.replay(w, r, idempotencyKey)
+
+			return
+		}
+
+		m.handle(w, r, next, idempotencyKey)
+	})
+}
+
+// handle serves request for the first time and stores its response.
+// Key is released on server errors and panics, so client can safely retry.
+func (m *Idempotency) handle(w http.ResponseWriter, r *http.Request, next http.Handler, key *idempotency.Key) {
+	recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+
+	defer func() {
+		if p := recover(); p != nil {
+			m.release(key)
+			panic(p)
+		}
+	}()
+
+	next.ServeHTTP(recorder, r)
+
+	if recorder.statusCode >= http.StatusInternalServerError {
+		m.release(key)
+
+		return
+	}
+
+	key.StatusCode = recorder.statusCode
+	key.Header = recorder.Header().Clone()
+	key.Body = recorder.body.Bytes()
+
+	if err := m.store.Complete(context.Background(), key); err != nil {
+		log.Printf("idempotency key %s completion failed: %s", key.Key, err)
+	}
+}
+
+// replay writes stored response of already handled request.
+func (m *Idempotency) replay(w http.ResponseWriter, r *http.Request, key *idempotency.Key) {
+	stored, err := m.store.Get(r.Context(), key.Key)
+	if err != nil {
+		log.Printf("idempotency key %s fetching failed: %s", key.Key, err)
+		writeError(w, r, http.StatusInternalServerError, infrastructure.ErrInternal)
+
+		return
+	}
+
+	switch {
+	case stored.Fingerprint != key.Fingerprint:
+		writeError(w, r, http.StatusUnprocessableEntity, ErrIdempotencyKeyReused)
+	case stored.InProgress():
+		writeError(w, r, http.StatusConflict, ErrIdempotencyKeyInProgress)
+	default:
+		for name, values := range stored.Header {
+			w.Header()[name] = values
+		}
+
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(stored.StatusCode)
+
+		_, _ = w.Write(stored.Body)
+	}
+}
+
+// release removes key. Context not bound to the request is used because request might be already canceled.
+func (m *Idempotency) release(key *idempotency.Key) {
+	if err := m.store.Release(context.Background(), key.Key); err != nil {
+		log.Printf("idempotency key %s release failed: %s", key.Key, err)
+	}
+}
+
+// fingerprint identifies request by its method, path without API version prefix and body.
+func fingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + "\n" + infrastructure.ResourcePath(r) + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// isMutating reports whether http method changes server state.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// writeError writes error in format negotiated by infrastructure.AcceptsProblem.
+func writeError(w http.ResponseWriter, r *http.Request, status int, err error) {
+	_ = infrastructure.WriteErrorResponse(w, r, status, err)
+}
+
+// responseRecorder represents http.ResponseWriter keeping copy of written response.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+// WriteHeader implements http.ResponseWriter interface.
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if !r.wroteHeader {
+		r.statusCode = statusCode
+		r.wroteHeader = true
+	}
+
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write implements http.ResponseWriter interface.
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(data)
+
+	return r.ResponseWriter.Write(data)
+}
@@ -0,0 +1,244 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/idempotency"
+)
+
+var errTestKeyNotFound = errors.New("idempotency key not found")
+
+type inMemoryStore struct {
+	mu   sync.Mutex
+	keys map[string]idempotency.Key
+}
+
+func newInMemoryStore() *inMemoryStore {
+	return &inMemoryStore{keys: make(map[string]idempotency.Key)}
+}
+
+func (s *inMemoryStore) Reserve(_ context.Context, key *idempotency.Key) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stored, ok := s.keys[key.Key]; ok && stored.ExpiresAt.After(key.CreatedAt) {
+		abandoned := stored.InProgress() && !stored.LockedUntil.After(key.CreatedAt)
+		if !abandoned || stored.Fingerprint != key.Fingerprint {
+			return false, nil
+		}
+	}
+
+	s.keys[key.Key] = *key
+
+	return true, nil
+}
+
+func (s *inMemoryStore) Get(_ context.Context, key string) (*idempotency.Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.keys[key]
+	if !ok {
+		return nil, errTestKeyNotFound
+	}
+
+	return &stored, nil
+}
+
+func (s *inMemoryStore) Complete(_ context.Context, key *idempotency.Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key.Key] = *key
+
+	return nil
+}
+
+func (s *inMemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, key)
+
+	return nil
+}
+
+func (s *inMemoryStore) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+
+	for key, stored := range s.keys {
+		if !stored.ExpiresAt.After(now) {
+			delete(s.keys, key)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// countingHandler responds with given status and counts how many times it was called.
+type countingHandler struct {
+	status int
+	calls  int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.calls++
+
+	body, _ := io.ReadAll(r.Body)
+
+	w.Header().Set("Location", "/verifications/1")
+	w.WriteHeader(h.status)
+	_, _ = w.Write(body)
+}
+
+func serve(handler http.Handler, method, key, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, "/verifications", strings.NewReader(body))
+	if key != "" {
+		request.Header.Set(IdempotencyKeyHeader, key)
+	}
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+
+	return response
+}
+
+func TestIdempotencyReplaysStoredResponse(t *testing.T) {
+	// assign
+	next := &countingHandler{status: http.StatusCreated}
+	handler := NewIdempotency(newInMemoryStore(), time.Hour, time.Minute).Handler(next)
+
+	// act
+	first := serve(handler, http.MethodPost, "key-1", `{"kind":"identity"}`)
+	second := serve(handler, http.MethodPost, "key-1", `{"kind":"identity"}`)
+
+	// assert
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "/verifications/1", second.Header().Get("Location"))
+	assert.Equal(t, "true", second.Header().Get(IdempotentReplayedHeader))
+	assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+}
+
+func TestIdempotencyRejectsKeyReusedWithDifferentRequest(t *testing.T) {
+	// assign
+	next := &countingHandler{status: http.StatusCreated}
+	handler := NewIdempotency(newInMemoryStore(), time.Hour, time.Minute).Handler(next)
+
+	// act
+	serve(handler, http.MethodPost, "key-1", `{"kind":"identity"}`)
+	response := serve(handler, http.MethodPost, "key-1", `{"kind":"document"}`)
+
+	// assert
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	assert.Contains(t, response.Body.String(), ErrIdempotencyKeyReused.Error())
+}
+
+func TestIdempotencyRejectsKeyInProgress(t *testing.T) {
+	// assign
+	store := newInMemoryStore()
+	next := &countingHandler{status: http.StatusCreated}
+	handler := NewIdempotency(store, time.Hour, time.Minute).Handler(next)
+
+	_, err := store.Reserve(context.Background(), idempotency.NewKey(
+		"key-1",
+		fingerprint(httptest.NewRequest(http.MethodPost, "/verifications", nil), []byte(`{}`)),
+		time.Hour,
+		time.Minute,
+	))
+	require.NoError(t, err)
+
+	// act
+	response := serve(handler, http.MethodPost, "key-1", `{}`)
+
+	// assert
+	assert.Equal(t, 0, next.calls)
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
+
+func TestIdempotencyTakesOverAbandonedKey(t *testing.T) {
+	// assign
+	store := newInMemoryStore()
+	next := &countingHandler{status: http.StatusCreated}
+	handler := NewIdempotency(store, time.Hour, time.Minute).Handler(next)
+
+	_, err := store.Reserve(context.Background(), idempotency.NewKey(
+		"key-1",
+		fingerprint(httptest.NewRequest(http.MethodPost, "/verifications", nil), []byte(`{}`)),
+		time.Hour,
+		-time.Second,
+	))
+	require.NoError(t, err)
+
+	// act
+	response := serve(handler, http.MethodPost, "key-1", `{}`)
+
+	// assert
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, http.StatusCreated, response.Code)
+}
+
+func TestIdempotencyReplaysResponseForLegacyAlias(t *testing.T) {
+	// assign
+	next := &countingHandler{status: http.StatusCreated}
+	handler := NewIdempotency(newInMemoryStore(), time.Hour, time.Minute).Handler(next)
+	versioned := infrastructure.WithAPIPrefix("/v1")(handler)
+
+	request := httptest.NewRequest(http.MethodPost, "/v1/verifications", strings.NewReader(`{}`))
+	request.Header.Set(IdempotencyKeyHeader, "key-1")
+	versioned.ServeHTTP(httptest.NewRecorder(), request)
+
+	// act
+	response := serve(handler, http.MethodPost, "key-1", `{}`)
+
+	// assert
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, "true", response.Header().Get(IdempotentReplayedHeader))
+}
+
+func TestIdempotencyReleasesKeyOnServerError(t *testing.T) {
+	// assign
+	next := &countingHandler{status: http.StatusInternalServerError}
+	handler := NewIdempotency(newInMemoryStore(), time.Hour, time.Minute).Handler(next)
+
+	// act
+	serve(handler, http.MethodPost, "key-1", `{}`)
+	serve(handler, http.MethodPost, "key-1", `{}`)
+
+	// assert
+	assert.Equal(t, 2, next.calls)
+}
+
+func TestIdempotencySkipsRequestsWithoutKey(t *testing.T) {
+	// assign
+	next := &countingHandler{status: http.StatusCreated}
+	handler := NewIdempotency(newInMemoryStore(), time.Hour, time.Minute).Handler(next)
+
+	// act
+	serve(handler, http.MethodPost, "", `{}`)
+	serve(handler, http.MethodPost, "", `{}`)
+	serve(handler, http.MethodGet, "key-1", "")
+	serve(handler, http.MethodGet, "key-1", "")
+
+	// assert
+	assert.Equal(t, 4, next.calls)
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+)
+
+// Shutdown represents middleware canceling contexts of long-running requests, e.g. exports,
+// when server shutdown starts, so they end instead of keeping shutdown waiting until timeout.
+type Shutdown struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewShutdown creates a new Shutdown middleware.
+func NewShutdown() *Shutdown {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Shutdown{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Cancel cancels contexts of running and further requests, it is registered to be called on server shutdown.
+func (m *Shutdown) Cancel() {
+	m.cancel()
+}
+
+// Handler implements chi middleware interface.
+func (m *Shutdown) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		go func() {
+			select {
+			case <-m.ctx.Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
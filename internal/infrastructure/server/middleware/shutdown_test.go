@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdownCancelsRunningRequest(t *testing.T) {
+	// assign
+	shutdown := NewShutdown()
+	started := make(chan struct{})
+
+	handler := shutdown.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+
+		select {
+		case <-r.Context().Done():
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case <-time.After(time.Second):
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+
+	recorder := httptest.NewRecorder()
+	done := make(chan struct{})
+
+	// act
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/verifications/export", nil))
+	}()
+
+	<-started
+	shutdown.Cancel()
+	<-done
+
+	// assert
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
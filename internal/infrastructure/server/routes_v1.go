@@ -0,0 +1,97 @@
+package server
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+	appMiddleware "github.com/vitalii-tkachuk/verification-service/internal/infrastructure/server/middleware"
+	"github.com/vitalii-tkachuk/verification-service/internal/ui/handler/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/ui/handler/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/internal/ui/handler/job"
+	"github.com/vitalii-tkachuk/verification-service/internal/ui/handler/saga"
+	"github.com/vitalii-tkachuk/verification-service/internal/ui/handler/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/internal/ui/handler/verification"
+)
+
+// apiV1Prefix is the path prefix of API version 1 routes.
+const apiV1Prefix = "/v1"
+
+// registerV1Routes registers API version 1 routes on r with Cache-Control policy of every route.
+// Exports are canceled by shutdown middleware, like event streams are ended by closing the broker.
+// Next version gets its own register function reusing handlers whose responses are unchanged
+// and registering new handlers for resources with changed request or response structures.
+func registerV1Routes(
+	r chi.Router,
+	application *infrastructure.Application,
+	idempotency *appMiddleware.Idempotency,
+	shutdown *appMiddleware.Shutdown,
+) {
+	r.Route("/verifications", func(r chi.Router) {
+		r.Use(idempotency.Handler)
+
+		r.Group(func(r chi.Router) {
+			r.Use(appMiddleware.CacheControl(appMiddleware.CacheControlRevalidate))
+
+			r.Get("/", verification.ListVerificationsHandler(application))
+			r.Get("/search", verification.SearchVerificationsHandler(application))
+			r.Get("/{verificationUuid}", verification.GetVerificationHandler(application))
+			r.Get("/{verificationUuid}/timeline", verification.GetVerificationTimelineHandler(application))
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(appMiddleware.CacheControl(appMiddleware.CacheControlNoStore))
+
+			r.Post("/", verification.CreateVerificationHandler(application))
+			r.Post("/batch", verification.BatchVerificationHandler(application))
+			r.With(shutdown.Handler).Get("/export", verification.ExportVerificationsHandler(application))
+			r.Put("/{verificationUuid}", verification.PutVerificationHandler(application))
+			r.Patch("/{verificationUuid}/approve", verification.ApproveVerificationHandler(application))
+			r.Patch("/{verificationUuid}/decline", verification.DeclineVerificationHandler(application))
+			r.Patch("/{verificationUuid}/cancel", verification.CancelVerificationHandler(application))
+			r.Get("/{verificationUuid}/events", verification.VerificationEventsHandler(application))
+		})
+	})
+
+	r.Group(func(r chi.Router) {
+		r.Use(appMiddleware.CacheControl(appMiddleware.CacheControlShortLived))
+
+		r.Get("/dashboard/verifications", verification.DashboardHandler(application))
+		r.Get("/stats/verifications", verification.VerificationStatsHandler(application))
+	})
+
+	r.Group(func(r chi.Router) {
+		r.Use(appMiddleware.CacheControl(appMiddleware.CacheControlNoStore))
+
+		r.Get("/jobs/{jobId}", job.GetJobHandler(application))
+	})
+}
+
+// registerV1InternalRoutes registers API version 1 routes served only on internal port.
+// Global events stream carries status changes of every verification, so it is for internal consumers only.
+func registerV1InternalRoutes(r chi.Router, application *infrastructure.Application) {
+	r.Use(appMiddleware.CacheControl(appMiddleware.CacheControlNoStore))
+
+	r.Get("/events", verification.EventsHandler(application))
+
+	registerAdminRoutes(r, application)
+}
+
+// registerAdminRoutes registers administration routes on r. They let caller dispatch arbitrary commands,
+// so they must not be exposed on public API.
+func registerAdminRoutes(r chi.Router, application *infrastructure.Application) {
+	r.Get("/admin/bus", bus.ListBusHandlersHandler(application))
+	r.Get("/admin/sagas", saga.ListSagasHandler(application))
+
+	r.Route("/admin/scheduled-commands", func(r chi.Router) {
+		r.Get("/", scheduler.ListScheduledCommandsHandler(application))
+		r.Post("/", scheduler.ScheduleCommandHandler(application))
+		r.Post("/{scheduledCommandId}/cancel", scheduler.CancelScheduledCommandHandler(application))
+		r.Post("/{scheduledCommandId}/trigger", scheduler.TriggerScheduledCommandHandler(application))
+	})
+
+	r.Route("/admin/dead-letters", func(r chi.Router) {
+		r.Get("/", deadletter.ListDeadLettersHandler(application))
+		r.Get("/{deadLetterId}", deadletter.GetDeadLetterHandler(application))
+		r.Post("/{deadLetterId}/replay", deadletter.ReplayDeadLetterHandler(application))
+		r.Delete("/{deadLetterId}", deadletter.DiscardDeadLetterHandler(application))
+	})
+}
@@ -2,37 +2,62 @@ package server
 
 import (
 	"context"
+	"errors"
+	"expvar"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/config"
-	"github.com/vitalii-tkachuk/verification-service/internal/ui/handler/verification"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/idempotency"
+	appMiddleware "github.com/vitalii-tkachuk/verification-service/internal/infrastructure/server/middleware"
 )
 
 // Server represents abstraction over http.Server.
+// API is served on port, diagnostics are served on separate internal port which is not exposed publicly.
 type Server struct {
 	port            uint8
+	internalPort    uint16
 	shutdownTimeout time.Duration
 	router          *chi.Mux
+	internalRouter  *chi.Mux
+	shutdownHooks   []func(context.Context) error
+	onShutdown      []func()
 }
 
 // NewServer create Server struct.
-func NewServer(ctx context.Context, cfg config.Config, application *infrastructure.Application) (context.Context, *Server) {
+func NewServer(
+	ctx context.Context,
+	cfg config.Config,
+	application *infrastructure.Application,
+	idempotencyKeyStore idempotency.Store,
+) (context.Context, *Server) {
 	srv := &Server{
 		port:            cfg.Port,
+		internalPort:    cfg.InternalPort,
 		shutdownTimeout: cfg.ShutdownTimeout,
 		router:          chi.NewRouter(),
+		internalRouter:  chi.NewRouter(),
 	}
 
+	shutdown := appMiddleware.NewShutdown()
+
 	srv.registerMiddlewares()
-	srv.registerRoutes(application)
+	srv.registerRoutes(
+		application,
+		appMiddleware.NewIdempotency(idempotencyKeyStore, cfg.IdempotencyKeyTTL, cfg.IdempotencyKeyLockTimeout),
+		appMiddleware.NewDeprecation(cfg.LegacyRoutesDeprecatedAt, cfg.LegacyRoutesSunset, apiV1Prefix),
+		shutdown,
+	)
+	srv.registerInternalRoutes(application)
+	srv.RegisterOnShutdown(shutdown.Cancel)
 
 	return serverContext(ctx), srv
 }
@@ -43,35 +68,137 @@ func (s *Server) registerMiddlewares() {
 }
 
 // registerRoutes is used for chi.Router routes configuration.
-func (s *Server) registerRoutes(application *infrastructure.Application) {
-	s.router.Route("/verifications", func(r chi.Router) {
-		r.Post("/", verification.CreateVerificationHandler(application))
-		r.Get("/{verificationUuid}", verification.GetVerificationHandler(application))
-		r.Patch("/{verificationUuid}/approve", verification.ApproveVerificationHandler(application))
-		r.Patch("/{verificationUuid}/decline", verification.DeclineVerificationHandler(application))
+// Every API version is mounted under its prefix, unversioned legacy aliases of v1 routes are kept
+// for transition period and answered with deprecation headers.
+func (s *Server) registerRoutes(
+	application *infrastructure.Application,
+	idempotency *appMiddleware.Idempotency,
+	deprecation *appMiddleware.Deprecation,
+	shutdown *appMiddleware.Shutdown,
+) {
+	s.router.Route(apiV1Prefix, func(r chi.Router) {
+		r.Use(infrastructure.WithAPIPrefix(apiV1Prefix))
+		registerV1Routes(r, application, idempotency, shutdown)
+	})
+
+	s.router.Group(func(r chi.Router) {
+		r.Use(deprecation.Handler)
+		registerV1Routes(r, application, idempotency, shutdown)
+	})
+}
+
+// registerInternalRoutes is used for internal chi.Router routes configuration.
+// Memory stats, command line, cache counters and administration routes are only available on internal port.
+// Internal routes are versioned without unversioned legacy aliases.
+func (s *Server) registerInternalRoutes(application *infrastructure.Application) {
+	s.internalRouter.Use(middleware.Recoverer)
+	s.internalRouter.Handle("/debug/vars", expvar.Handler())
+
+	s.internalRouter.Route(apiV1Prefix, func(r chi.Router) {
+		r.Use(infrastructure.WithAPIPrefix(apiV1Prefix))
+		registerV1InternalRoutes(r, application)
 	})
 }
 
-// Run starts http.Server and wait for context.Context signal to shutdown server gracefully.
+// RegisterShutdownHook adds function called after http.Server shutdown e.g. to drain background workers.
+// Every hook is called even if http.Server shutdown or another hook failed.
+func (s *Server) RegisterShutdownHook(hook func(context.Context) error) {
+	s.shutdownHooks = append(s.shutdownHooks, hook)
+}
+
+// RegisterOnShutdown adds function called when http.Server shutdown starts, e.g. to end long-lived streams,
+// because shutdown waits for open connections to become idle and would otherwise last until timeout.
+func (s *Server) RegisterOnShutdown(fn func()) {
+	s.onShutdown = append(s.onShutdown, fn)
+}
+
+// Run starts API and internal http.Server and wait for context.Context signal to shutdown servers gracefully.
 func (s *Server) Run(ctx context.Context) error {
-	log.Printf("Server is running on %d", s.port)
+	log.Printf("Server is running on %d, internal server on %d", s.port, s.internalPort)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", s.port),
 		Handler: s.router,
 	}
 
-	go func() {
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatal("server shut down", err)
-		}
-	}()
+	internalSrv := &http.Server{
+		Addr:    fmt.Sprintf(":%d", s.internalPort),
+		Handler: s.internalRouter,
+	}
+
+	for _, fn := range s.onShutdown {
+		srv.RegisterOnShutdown(fn)
+	}
+
+	for _, server := range []*http.Server{srv, internalSrv} {
+		go listenAndServe(server)
+	}
 
 	<-ctx.Done()
 	ctxShutDown, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
 	defer cancel()
 
-	return srv.Shutdown(ctxShutDown)
+	errs := []error{srv.Shutdown(ctxShutDown), internalSrv.Shutdown(ctxShutDown)}
+
+	// hooks get their own timeout and run even if http.Server shutdown failed,
+	// so background workers are drained and their in-flight jobs are not lost
+	ctxHooks, cancelHooks := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancelHooks()
+
+	for _, hook := range s.shutdownHooks {
+		errs = append(errs, hook(ctxHooks))
+	}
+
+	return newShutdownError(errs)
+}
+
+// shutdownError collects errors of servers and hooks shutdown, so a failing hook does not hide failures of others.
+type shutdownError []error
+
+// newShutdownError returns shutdownError of non nil errs, or nil if there are none.
+func newShutdownError(errs []error) error {
+	var shutdownErr shutdownError
+
+	for _, err := range errs {
+		if err != nil {
+			shutdownErr = append(shutdownErr, err)
+		}
+	}
+
+	if len(shutdownErr) == 0 {
+		return nil
+	}
+
+	return shutdownErr
+}
+
+// Error implements error interface.
+func (e shutdownError) Error() string {
+	messages := make([]string, len(e))
+
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any of collected errors matches target, so errors.Is works as for a single error.
+func (e shutdownError) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listenAndServe starts srv and stops the process if it fails for other reason than shutdown.
+func listenAndServe(srv *http.Server) {
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Fatal("server shut down", err)
+	}
 }
 
 // serverContext call cancel shutdown context function in case os.Interrupt signal received.
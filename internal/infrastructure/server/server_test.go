@@ -0,0 +1,556 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure/persistence/postgres"
+	appMiddleware "github.com/vitalii-tkachuk/verification-service/internal/infrastructure/server/middleware"
+)
+
+const (
+	testVerificationUUID = "0b7e8d47-0a4e-4c4a-9a59-3f4f7e0f3b6b"
+	testJobID            = "1f0c3b1e-7d7e-4e37-9d0f-2a8a3f5a6b01"
+)
+
+var (
+	testDeprecatedAt = time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	testSunset       = time.Date(2027, time.May, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var errTestDatabase = errors.New(`pq: relation "verifications" does not exist`)
+
+// failingCommandBus fails every dispatched command with err.
+type failingCommandBus struct {
+	err error
+}
+
+func (b failingCommandBus) Dispatch(context.Context, bus.Command) error { return b.err }
+
+func (b failingCommandBus) Enqueue(context.Context, bus.Command) (string, error) {
+	return testJobID, b.err
+}
+
+func (b failingCommandBus) Register(bus.CommandType, bus.CommandHandler) error { return nil }
+
+// failingQueryBus fails every query with err.
+type failingQueryBus struct {
+	err error
+}
+
+func (b failingQueryBus) Ask(context.Context, bus.Query) (any, error) { return nil, b.err }
+
+func (b failingQueryBus) Register(bus.QueryType, bus.QueryHandler) error { return nil }
+
+// directUnitOfWork runs function without transaction.
+type directUnitOfWork struct{}
+
+func (directUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// newTestRouter creates public router with all routes whose commands and queries fail with err.
+func newTestRouter(err error) *chi.Mux {
+	return newTestServer(err).router
+}
+
+// newTestServer creates Server with all public and internal routes whose commands and queries fail with err.
+func newTestServer(err error) *Server {
+	return newApplicationServer(failingCommandBus{err: err}, failingQueryBus{err: err})
+}
+
+// newApplicationRouter creates public router with all routes handled with commandBus and queryBus.
+func newApplicationRouter(commandBus failingCommandBus, queryBus bus.QueryBus) *chi.Mux {
+	return newApplicationServer(commandBus, queryBus).router
+}
+
+// newApplicationServer creates Server with all public and internal routes handled with commandBus and queryBus.
+func newApplicationServer(commandBus failingCommandBus, queryBus bus.QueryBus) *Server {
+	srv := &Server{router: chi.NewRouter(), internalRouter: chi.NewRouter()}
+	application := infrastructure.NewApplication(
+		commandBus,
+		commandBus,
+		queryBus,
+		directUnitOfWork{},
+		validator.New(),
+	)
+
+	srv.registerRoutes(
+		application,
+		appMiddleware.NewIdempotency(nil, time.Hour, time.Minute),
+		appMiddleware.NewDeprecation(testDeprecatedAt, testSunset, apiV1Prefix),
+		appMiddleware.NewShutdown(),
+	)
+	srv.registerInternalRoutes(application)
+
+	return srv
+}
+
+type routeRequest struct {
+	method   string
+	path     string
+	body     string
+	internal bool
+}
+
+var (
+	createBody  = `{"kind":"identity","description":"Selfie with passport"}`
+	declineBody = `{"declineReason":"Document is expired"}`
+
+	verificationCommandRoutes = []routeRequest{
+		{method: http.MethodPost, path: "/verifications", body: createBody},
+		{method: http.MethodPut, path: "/verifications/" + testVerificationUUID, body: createBody},
+		{method: http.MethodPatch, path: "/verifications/" + testVerificationUUID + "/approve"},
+		{method: http.MethodPatch, path: "/verifications/" + testVerificationUUID + "/decline", body: declineBody},
+		{method: http.MethodPatch, path: "/verifications/" + testVerificationUUID + "/cancel"},
+	}
+
+	verificationQueryRoutes = []routeRequest{
+		{method: http.MethodGet, path: "/verifications"},
+		{method: http.MethodGet, path: "/verifications/search?q=passport"},
+		{method: http.MethodGet, path: "/verifications/export"},
+		{method: http.MethodGet, path: "/verifications/" + testVerificationUUID},
+		{method: http.MethodGet, path: "/verifications/" + testVerificationUUID + "/timeline"},
+		{method: http.MethodGet, path: "/verifications/" + testVerificationUUID + "/events"},
+		{method: http.MethodGet, path: "/dashboard/verifications"},
+		{method: http.MethodGet, path: "/stats/verifications"},
+	}
+
+	jobRoutes = []routeRequest{
+		{method: http.MethodGet, path: "/jobs/1"},
+	}
+
+	// internalRoutes are served only on internal router under API version prefix.
+	internalRoutes = []routeRequest{
+		{method: http.MethodGet, path: "/v1/events?status=approved,declined", internal: true},
+		{method: http.MethodGet, path: "/v1/admin/sagas", internal: true},
+		{method: http.MethodGet, path: "/v1/admin/scheduled-commands", internal: true},
+		{method: http.MethodPost, path: "/v1/admin/scheduled-commands/1/cancel", internal: true},
+		{method: http.MethodPost, path: "/v1/admin/scheduled-commands/1/trigger", internal: true},
+		{method: http.MethodGet, path: "/v1/admin/dead-letters", internal: true},
+		{method: http.MethodGet, path: "/v1/admin/dead-letters/1", internal: true},
+		{method: http.MethodPost, path: "/v1/admin/dead-letters/1/replay", internal: true},
+		{method: http.MethodDelete, path: "/v1/admin/dead-letters/1", internal: true},
+	}
+)
+
+// allRoutes returns every public and internal route request.
+func allRoutes() []routeRequest {
+	routes := append([]routeRequest{}, verificationCommandRoutes...)
+	routes = append(routes, verificationQueryRoutes...)
+	routes = append(routes, jobRoutes...)
+
+	return append(routes, internalRoutes...)
+}
+
+// routerFor returns srv router serving route: internal router for internal routes, public router otherwise.
+func routerFor(srv *Server, route routeRequest) http.Handler {
+	if route.internal {
+		return srv.internalRouter
+	}
+
+	return srv.router
+}
+
+// serve sends route request to router and returns recorded response.
+func serve(router http.Handler, route routeRequest) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(route.method, route.path, strings.NewReader(route.body))
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	return recorder
+}
+
+// assertRoutesStatus asserts every route answers with status and body containing err message
+// when its command or query fails with err.
+func assertRoutesStatus(t *testing.T, routes []routeRequest, err error, status int) {
+	assertRoutesResponse(t, routes, err, status, err.Error())
+}
+
+// assertRoutesResponse asserts every route answers with status and body containing message
+// when its command or query fails with err.
+func assertRoutesResponse(t *testing.T, routes []routeRequest, err error, status int, message string) {
+	srv := newTestServer(err)
+
+	for _, route := range routes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			// act
+			response := serve(routerFor(srv, route), route)
+
+			// assert
+			assert.Equal(t, status, response.Code)
+			assert.Contains(t, response.Body.String(), message)
+		})
+	}
+}
+
+func TestRoutesRespondNotFound(t *testing.T) {
+	notFound := fmt.Errorf("%w: %s", postgres.ErrVerificationNotFound, testVerificationUUID)
+
+	assertRoutesStatus(t, verificationCommandRoutes[2:], notFound, http.StatusNotFound)
+	assertRoutesStatus(t, verificationQueryRoutes[3:5], notFound, http.StatusNotFound)
+	assertRoutesStatus(t, jobRoutes, fmt.Errorf("%w: 1", postgres.ErrJobNotFound), http.StatusNotFound)
+	assertRoutesStatus(t, internalRoutes[3:5], fmt.Errorf("%w: 1", scheduler.ErrEntryNotFound), http.StatusNotFound)
+	assertRoutesStatus(t, internalRoutes[6:], fmt.Errorf("%w: 1", deadletter.ErrLetterNotFound), http.StatusNotFound)
+}
+
+func TestRoutesRespondConflict(t *testing.T) {
+	assertRoutesStatus(t, verificationCommandRoutes[:1], aggregate.ErrVerificationAlreadyExists, http.StatusConflict)
+	assertRoutesStatus(t, verificationCommandRoutes[2:], aggregate.ErrAlreadyProcessed, http.StatusConflict)
+	assertRoutesStatus(t, internalRoutes[3:5], scheduler.ErrEntryNotScheduled, http.StatusConflict)
+}
+
+func TestRoutesRespondUnprocessableEntity(t *testing.T) {
+	var validationError bus.ValidationError
+
+	validationError.Add("uuid", aggregate.ErrInvalidVerificationUUID)
+
+	routes := allRoutes()
+
+	assertRoutesResponse(t, routes, validationError.ErrorOrNil(), http.StatusUnprocessableEntity, `"propertyPath":"uuid"`)
+}
+
+func TestRoutesHideInternalErrors(t *testing.T) {
+	srv := newTestServer(fmt.Errorf("%s: %w", postgres.ErrVerificationPersistFailed, errTestDatabase))
+	routes := allRoutes()
+
+	for _, route := range routes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			// act
+			response := serve(routerFor(srv, route), route)
+
+			// assert
+			assert.Equal(t, http.StatusInternalServerError, response.Code)
+			assert.JSONEq(t, `{"errors":[{"message":"internal error"}]}`, response.Body.String())
+		})
+	}
+}
+
+func TestRoutesRespondBadRequest(t *testing.T) {
+	srv := newTestServer(nil)
+	routes := []routeRequest{
+		{method: http.MethodPost, path: "/verifications"},
+		{method: http.MethodPost, path: "/verifications", body: `{"kind":`},
+		{method: http.MethodPatch, path: "/verifications/" + testVerificationUUID + "/decline", body: `{}{}`},
+		{method: http.MethodGet, path: "/verifications?limit=-1"},
+		{method: http.MethodGet, path: "/dashboard/verifications?offset=x"},
+		{method: http.MethodGet, path: "/stats/verifications?from=yesterday"},
+		{method: http.MethodGet, path: "/v1/admin/sagas?stuck=maybe", internal: true},
+	}
+
+	for _, route := range routes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			// act
+			response := serve(routerFor(srv, route), route)
+
+			// assert
+			assert.Equal(t, http.StatusBadRequest, response.Code)
+		})
+	}
+}
+
+func TestBatchRouteHidesInternalErrors(t *testing.T) {
+	// assign
+	router := newTestRouter(errTestDatabase)
+	route := routeRequest{
+		method: http.MethodPost,
+		path:   "/verifications/batch",
+		body:   `{"operations":[{"operation":"approve","uuid":"` + testVerificationUUID + `"}]}`,
+	}
+
+	// act
+	response := serve(router, route)
+
+	// assert
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Body.String(), `"error":"internal error"`)
+	assert.NotContains(t, response.Body.String(), errTestDatabase.Error())
+}
+
+// serveProblem sends route request accepting problem details to router and returns recorded response.
+func serveProblem(router http.Handler, route routeRequest) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(route.method, route.path, strings.NewReader(route.body))
+	request.Header.Set("Accept", infrastructure.ProblemContentType)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	return recorder
+}
+
+func TestRoutesRespondProblemDetails(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		route routeRequest
+		body  string
+	}{
+		{
+			name:  "not found",
+			err:   fmt.Errorf("%w: %s", postgres.ErrVerificationNotFound, testVerificationUUID),
+			route: routeRequest{method: http.MethodGet, path: "/verifications/" + testVerificationUUID},
+			body: `{
+				"type": "/problems/verification_not_found",
+				"title": "Not Found",
+				"status": 404,
+				"detail": "verification not found: ` + testVerificationUUID + `",
+				"instance": "/verifications/` + testVerificationUUID + `",
+				"code": "verification_not_found"
+			}`,
+		},
+		{
+			name:  "conflict",
+			err:   aggregate.ErrAlreadyProcessed,
+			route: routeRequest{method: http.MethodPatch, path: "/verifications/" + testVerificationUUID + "/cancel"},
+			body: `{
+				"type": "/problems/verification_already_processed",
+				"title": "Conflict",
+				"status": 409,
+				"detail": "verification is already processed",
+				"instance": "/verifications/` + testVerificationUUID + `/cancel",
+				"code": "verification_already_processed"
+			}`,
+		},
+		{
+			name:  "bad request parameter",
+			route: routeRequest{method: http.MethodGet, path: "/verifications?limit=-1"},
+			body: `{
+				"type": "/problems/invalid_limit_parameter",
+				"title": "Bad Request",
+				"status": 400,
+				"detail": "limit parameter must be a positive integer",
+				"instance": "/verifications",
+				"code": "invalid_limit_parameter"
+			}`,
+		},
+		{
+			name:  "internal error",
+			err:   errTestDatabase,
+			route: routeRequest{method: http.MethodGet, path: "/jobs/1"},
+			body: `{
+				"type": "/problems/internal_error",
+				"title": "Internal Server Error",
+				"status": 500,
+				"detail": "internal error",
+				"instance": "/jobs/1",
+				"code": "internal_error"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// act
+			response := serveProblem(newTestRouter(tt.err), tt.route)
+
+			// assert
+			assert.Equal(t, infrastructure.ProblemContentType, response.Header().Get("Content-Type"))
+			assert.JSONEq(t, tt.body, response.Body.String())
+		})
+	}
+}
+
+func TestValidationProblemDetailsListFieldCodes(t *testing.T) {
+	// assign
+	var validationError bus.ValidationError
+
+	validationError.Add("uuid", fmt.Errorf("%w: %s", aggregate.ErrInvalidVerificationUUID, "not-uuid"))
+	validationError.Add("q", bus.ErrFieldRequired)
+	validationError.Addf("limit", bus.ErrFieldOutOfRange, "must be between 1 and %d", 100)
+	validationError.Addf("description", bus.ErrFieldTooShort, "must be at least %d characters long", 10)
+	validationError.Add("sort", errors.New("unknown sort"))
+
+	router := newTestRouter(validationError.ErrorOrNil())
+
+	// act
+	commandResponse := serveProblem(router, routeRequest{method: http.MethodGet, path: "/verifications/search"})
+	requestResponse := serveProblem(router, routeRequest{method: http.MethodPost, path: "/verifications", body: `{"kind":"face"}`})
+
+	// assert
+	assert.Equal(t, http.StatusUnprocessableEntity, commandResponse.Code)
+	assert.JSONEq(t, `{
+		"type": "/problems/validation_failed",
+		"title": "Unprocessable Entity",
+		"status": 422,
+		"detail": "validation failed",
+		"instance": "/verifications/search",
+		"code": "validation_failed",
+		"errors": [
+			{"code": "invalid_verification_uuid", "detail": "invalid verification uuid: not-uuid", "propertyPath": "uuid"},
+			{"code": "required", "detail": "must not be empty", "propertyPath": "q"},
+			{"code": "out_of_range", "detail": "must be between 1 and 100", "propertyPath": "limit"},
+			{"code": "too_short", "detail": "must be at least 10 characters long", "propertyPath": "description"},
+			{"code": "invalid_value", "detail": "unknown sort", "propertyPath": "sort"}
+		]
+	}`, commandResponse.Body.String())
+	assert.Equal(t, http.StatusUnprocessableEntity, requestResponse.Code)
+	assert.Contains(t, requestResponse.Body.String(), `{"code":"required","detail":"Key: 'createVerificationRequest.Description'`)
+	assert.Contains(t, requestResponse.Body.String(), `"code":"invalid_value"`)
+}
+
+func TestRoutesKeepLegacyErrorFormat(t *testing.T) {
+	// assign
+	router := newTestRouter(aggregate.ErrAlreadyProcessed)
+	request := httptest.NewRequest(http.MethodPatch, "/verifications/"+testVerificationUUID+"/approve", nil)
+	request.Header.Set("Accept", "application/json, application/problem+json;q=0")
+	recorder := httptest.NewRecorder()
+
+	// act
+	router.ServeHTTP(recorder, request)
+
+	// assert
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"errors":[{"message":"verification is already processed"}]}`, recorder.Body.String())
+}
+
+func TestVersionedRoutesAreNotDeprecated(t *testing.T) {
+	// assign
+	srv := newTestServer(aggregate.ErrAlreadyProcessed)
+	routes := allRoutes()
+
+	for _, route := range routes {
+		if !route.internal {
+			route.path = apiV1Prefix + route.path
+		}
+
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			// act
+			response := serve(routerFor(srv, route), route)
+
+			// assert
+			assert.NotEqual(t, http.StatusNotFound, response.Code)
+			assert.NotEqual(t, http.StatusMethodNotAllowed, response.Code)
+			assert.Empty(t, response.Header().Get(appMiddleware.DeprecationHeader))
+			assert.Empty(t, response.Header().Get(appMiddleware.SunsetHeader))
+		})
+	}
+}
+
+func TestLegacyRoutesAreDeprecated(t *testing.T) {
+	// assign
+	router := newTestRouter(aggregate.ErrAlreadyProcessed)
+	route := routeRequest{method: http.MethodPatch, path: "/verifications/" + testVerificationUUID + "/approve"}
+
+	// act
+	response := serve(router, route)
+
+	// assert
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, "@1793491200", response.Header().Get(appMiddleware.DeprecationHeader))
+	assert.Equal(t, "Sat, 01 May 2027 00:00:00 GMT", response.Header().Get(appMiddleware.SunsetHeader))
+	assert.Equal(
+		t,
+		`</v1/verifications/`+testVerificationUUID+`/approve>; rel="successor-version"`,
+		response.Header().Get(appMiddleware.LinkHeader),
+	)
+}
+
+func TestAcceptedResponseLinksJobInRequestedVersion(t *testing.T) {
+	// assign
+	router := newTestRouter(nil)
+	versioned := httptest.NewRequest(http.MethodPatch, apiV1Prefix+"/verifications/"+testVerificationUUID+"/cancel", nil)
+	versioned.Header.Set("Prefer", "respond-async")
+	legacy := httptest.NewRequest(http.MethodPatch, "/verifications/"+testVerificationUUID+"/cancel", nil)
+	legacy.Header.Set("Prefer", "respond-async")
+	versionedResponse := httptest.NewRecorder()
+	legacyResponse := httptest.NewRecorder()
+
+	// act
+	router.ServeHTTP(versionedResponse, versioned)
+	router.ServeHTTP(legacyResponse, legacy)
+
+	// assert
+	assert.Equal(t, http.StatusAccepted, versionedResponse.Code)
+	assert.Equal(t, "/v1/jobs/"+testJobID, versionedResponse.Header().Get("Location"))
+	assert.Equal(t, http.StatusAccepted, legacyResponse.Code)
+	assert.Equal(t, "/jobs/"+testJobID, legacyResponse.Header().Get("Location"))
+}
+
+func TestRunCallsEveryShutdownHook(t *testing.T) {
+	// assign
+	errFirstHook := errors.New("first hook failed")
+	errSecondHook := errors.New("second hook failed")
+
+	var called []string
+
+	srv := &Server{shutdownTimeout: time.Second, router: chi.NewRouter(), internalRouter: chi.NewRouter()}
+	srv.RegisterShutdownHook(func(context.Context) error {
+		called = append(called, "first")
+
+		return errFirstHook
+	})
+	srv.RegisterShutdownHook(func(context.Context) error {
+		called = append(called, "second")
+
+		return errSecondHook
+	})
+	srv.RegisterShutdownHook(func(ctx context.Context) error {
+		called = append(called, "third")
+
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// act
+	err := srv.Run(ctx)
+
+	// assert
+	assert.Equal(t, []string{"first", "second", "third"}, called)
+	assert.ErrorIs(t, err, errFirstHook)
+	assert.ErrorIs(t, err, errSecondHook)
+}
+
+func TestDebugVarsServedOnlyOnInternalRouter(t *testing.T) {
+	// assign
+	srv := newTestServer(nil)
+	route := routeRequest{method: http.MethodGet, path: "/debug/vars"}
+
+	// act
+	public := serve(srv.router, route)
+	internal := serve(srv.internalRouter, route)
+
+	// assert
+	assert.Equal(t, http.StatusNotFound, public.Code)
+	assert.Equal(t, http.StatusOK, internal.Code)
+	assert.Contains(t, internal.Body.String(), `"memstats"`)
+}
+
+func TestInternalRoutesServedOnlyOnInternalRouter(t *testing.T) {
+	// assign
+	srv := newTestServer(errTestDatabase)
+
+	for _, route := range internalRoutes {
+		legacy := route
+		legacy.path = strings.TrimPrefix(route.path, apiV1Prefix)
+
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			// act
+			versioned := serve(srv.router, route)
+			unversioned := serve(srv.router, legacy)
+			internal := serve(srv.internalRouter, route)
+
+			// assert
+			assert.Equal(t, http.StatusNotFound, versioned.Code)
+			assert.Equal(t, http.StatusNotFound, unversioned.Code)
+			assert.Equal(t, http.StatusInternalServerError, internal.Code)
+		})
+	}
+}
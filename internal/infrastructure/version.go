@@ -0,0 +1,35 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// apiPrefixContextKey is the context.Context key API version prefix is stored under.
+type apiPrefixContextKey struct{}
+
+// WithAPIPrefix returns middleware storing prefix of API version routes are mounted under, e.g. "/v1".
+func WithAPIPrefix(prefix string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiPrefixContextKey{}, prefix)))
+		})
+	}
+}
+
+// APIPath returns path of resource in API version request is routed to, so links stay in the same version.
+// Path is returned as is for unversioned legacy routes.
+func APIPath(r *http.Request, path string) string {
+	prefix, _ := r.Context().Value(apiPrefixContextKey{}).(string)
+
+	return prefix + path
+}
+
+// ResourcePath returns requested path without API version prefix, so it is the same for versioned route
+// and its unversioned legacy alias.
+func ResourcePath(r *http.Request) string {
+	prefix, _ := r.Context().Value(apiPrefixContextKey{}).(string)
+
+	return strings.TrimPrefix(r.URL.Path, prefix)
+}
@@ -0,0 +1,59 @@
+package bus
+
+import (
+	"net/http"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/bus/query"
+	appBus "github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+// handlerResponse represents single registered handler of list bus handlers endpoint response structure.
+type handlerResponse struct {
+	Type    string `json:"type"`
+	Handler string `json:"handler"`
+}
+
+// listBusHandlersResponse represents list bus handlers endpoint response structure.
+type listBusHandlersResponse struct {
+	Commands []handlerResponse `json:"commands"`
+	Queries  []handlerResponse `json:"queries"`
+}
+
+// toHandlerResponses create handlerResponse list from appBus.HandlerInfo list.
+func toHandlerResponses(handlers []appBus.HandlerInfo) []handlerResponse {
+	responses := make([]handlerResponse, len(handlers))
+
+	for i, handler := range handlers {
+		responses[i] = handlerResponse{Type: handler.Type, Handler: handler.Handler}
+	}
+
+	return responses
+}
+
+// ListBusHandlersHandler returns an HTTP handler listing command and query types with their registered handlers.
+func ListBusHandlersHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handlers, err := appBus.Ask[query.ListBusHandlersQuery, query.BusHandlers](
+			r.Context(),
+			application.QueryBus,
+			query.NewListBusHandlersQuery(),
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		response := listBusHandlersResponse{
+			Commands: toHandlerResponses(handlers.Commands),
+			Queries:  toHandlerResponses(handlers.Queries),
+		}
+
+		if err := application.Marshall(w, http.StatusOK, response, nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package deadletter
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/deadletter/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/deadletter"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const listDeadLettersDefaultLimit = 100
+
+var ErrInvalidLimitParameter = infrastructure.NewCodedError("invalid_limit_parameter", "limit parameter must be a positive integer")
+
+// deadLetterResponse represents single dead letter of list and get dead letter endpoints response structure.
+type deadLetterResponse struct {
+	ID          string          `json:"id"`
+	CommandType string          `json:"commandType"`
+	Payload     json.RawMessage `json:"payload"`
+	Error       string          `json:"error"`
+	Attempts    uint            `json:"attempts"`
+	CreatedAt   time.Time       `json:"createdAt"`
+}
+
+// listDeadLettersResponse represents list dead letters endpoint response structure.
+type listDeadLettersResponse struct {
+	DeadLetters []deadLetterResponse `json:"deadLetters"`
+}
+
+// toDeadLetterResponse create deadLetterResponse from deadletter.Letter.
+func toDeadLetterResponse(letter *deadletter.Letter) deadLetterResponse {
+	return deadLetterResponse{
+		ID:          letter.ID,
+		CommandType: string(letter.CommandType),
+		Payload:     letter.Payload,
+		Error:       letter.Error,
+		Attempts:    letter.Attempts,
+		CreatedAt:   letter.CreatedAt,
+	}
+}
+
+// ListDeadLettersHandler returns an HTTP handler for dead-lettered commands listing.
+func ListDeadLettersHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := uint64(listDeadLettersDefaultLimit)
+		if value := r.URL.Query().Get("limit"); value != "" {
+			parsed, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidLimitParameter)
+
+				return
+			}
+
+			limit = parsed
+		}
+
+		letters, err := bus.Ask[query.ListDeadLettersQuery, []*deadletter.Letter](
+			r.Context(),
+			application.QueryBus,
+			query.NewListDeadLettersQuery(uint(limit)),
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		response := listDeadLettersResponse{DeadLetters: make([]deadLetterResponse, len(letters))}
+		for i, letter := range letters {
+			response.DeadLetters[i] = toDeadLetterResponse(letter)
+		}
+
+		if err := application.Marshall(w, http.StatusOK, response, nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
+
+// GetDeadLetterHandler returns an HTTP handler for dead-lettered command fetching.
+func GetDeadLetterHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := application.GetURLParam(r, "deadLetterId")
+
+		letter, err := bus.Ask[query.GetDeadLetterByIDQuery, *deadletter.Letter](
+			r.Context(),
+			application.QueryBus,
+			query.NewGetDeadLetterByIDQuery(id),
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, toDeadLetterResponse(letter), nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
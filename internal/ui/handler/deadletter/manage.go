@@ -0,0 +1,48 @@
+package deadletter
+
+import (
+	"net/http"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/deadletter/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+// deadLetterIDResponse represents replay dead letter endpoint response structure.
+type deadLetterIDResponse struct {
+	ID string `json:"id"`
+}
+
+// ReplayDeadLetterHandler returns an HTTP handler dispatching dead-lettered command once again.
+// Letter is removed only if command succeeds.
+func ReplayDeadLetterHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := application.GetURLParam(r, "deadLetterId")
+
+		if err := application.CommandBus.Dispatch(r.Context(), command.NewReplayDeadLetterCommand(id)); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, deadLetterIDResponse{ID: id}, nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
+
+// DiscardDeadLetterHandler returns an HTTP handler deleting dead-lettered command without dispatching it.
+func DiscardDeadLetterHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := application.GetURLParam(r, "deadLetterId")
+
+		if err := application.CommandBus.Dispatch(r.Context(), command.NewDiscardDeadLetterCommand(id)); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
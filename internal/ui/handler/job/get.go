@@ -0,0 +1,58 @@
+package job
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/job/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+// getJobByIDResponse represents get job by id endpoint response structure.
+type getJobByIDResponse struct {
+	ID          string    `json:"id"`
+	CommandType string    `json:"commandType"`
+	Status      string    `json:"status"`
+	Result      string    `json:"result,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// toJobByIDResponse create getJobByIDResponse from bus.Job.
+func toJobByIDResponse(job *bus.Job) *getJobByIDResponse {
+	return &getJobByIDResponse{
+		ID:          job.ID,
+		CommandType: string(job.CommandType),
+		Status:      string(job.Status),
+		Result:      job.Result,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+}
+
+// GetJobHandler returns an HTTP handler for background job fetching.
+func GetJobHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jobID := application.GetURLParam(r, "jobId")
+
+		getJobByIDQuery := query.NewGetJobByIDQuery(jobID)
+
+		job, err := bus.Ask[query.GetJobByIDQuery, *bus.Job](r.Context(), application.QueryBus, getJobByIDQuery)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		response := toJobByIDResponse(job)
+
+		if err := application.Marshall(w, http.StatusOK, response, nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
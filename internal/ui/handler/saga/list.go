@@ -0,0 +1,105 @@
+package saga
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/saga/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const listSagasDefaultLimit = 100
+
+var (
+	ErrInvalidStuckParameter = infrastructure.NewCodedError("invalid_stuck_parameter", "stuck parameter must be a boolean")
+	ErrInvalidLimitParameter = infrastructure.NewCodedError("invalid_limit_parameter", "limit parameter must be a positive integer")
+)
+
+// sagaResponse represents single saga of list sagas endpoint response structure.
+type sagaResponse struct {
+	Type        string            `json:"type"`
+	AggregateID string            `json:"aggregateId"`
+	Step        string            `json:"step"`
+	Status      string            `json:"status"`
+	Stuck       bool              `json:"stuck"`
+	Data        map[string]string `json:"data,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	TimeoutAt   *time.Time        `json:"timeoutAt,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+}
+
+// listSagasResponse represents list sagas endpoint response structure.
+type listSagasResponse struct {
+	Sagas []sagaResponse `json:"sagas"`
+}
+
+// toListSagasResponse create listSagasResponse from query.SagaView list.
+func toListSagasResponse(views []query.SagaView) listSagasResponse {
+	response := listSagasResponse{Sagas: make([]sagaResponse, len(views))}
+
+	for i, view := range views {
+		response.Sagas[i] = sagaResponse{
+			Type:        string(view.State.Type),
+			AggregateID: view.State.AggregateID,
+			Step:        view.State.Step,
+			Status:      string(view.State.Status),
+			Stuck:       view.Stuck,
+			Data:        view.State.Data,
+			Error:       view.State.Error,
+			TimeoutAt:   view.State.TimeoutAt,
+			CreatedAt:   view.State.CreatedAt,
+			UpdatedAt:   view.State.UpdatedAt,
+		}
+	}
+
+	return response
+}
+
+// ListSagasHandler returns an HTTP handler for saga states listing.
+func ListSagasHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parameters := r.URL.Query()
+
+		stuck := false
+		if value := parameters.Get("stuck"); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidStuckParameter)
+
+				return
+			}
+
+			stuck = parsed
+		}
+
+		limit := uint64(listSagasDefaultLimit)
+		if value := parameters.Get("limit"); value != "" {
+			parsed, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidLimitParameter)
+
+				return
+			}
+
+			limit = parsed
+		}
+
+		listSagasQuery := query.NewListSagasQuery(parameters.Get("status"), stuck, uint(limit))
+
+		views, err := bus.Ask[query.ListSagasQuery, []query.SagaView](r.Context(), application.QueryBus, listSagasQuery)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, toListSagasResponse(views), nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/scheduler/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/scheduler"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const listScheduledCommandsDefaultLimit = 100
+
+var ErrInvalidLimitParameter = infrastructure.NewCodedError("invalid_limit_parameter", "limit parameter must be a positive integer")
+
+// scheduledCommandResponse represents single scheduled command of list scheduled commands endpoint response structure.
+type scheduledCommandResponse struct {
+	ID          string          `json:"id"`
+	CommandType string          `json:"commandType"`
+	Payload     json.RawMessage `json:"payload"`
+	Cron        string          `json:"cron,omitempty"`
+	Status      string          `json:"status"`
+	RunAt       time.Time       `json:"runAt"`
+	Runs        int             `json:"runs"`
+	LastRunAt   *time.Time      `json:"lastRunAt,omitempty"`
+	LastError   string          `json:"lastError,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+// listScheduledCommandsResponse represents list scheduled commands endpoint response structure.
+type listScheduledCommandsResponse struct {
+	ScheduledCommands []scheduledCommandResponse `json:"scheduledCommands"`
+}
+
+// toListScheduledCommandsResponse create listScheduledCommandsResponse from scheduler.Entry list.
+func toListScheduledCommandsResponse(entries []*scheduler.Entry) listScheduledCommandsResponse {
+	response := listScheduledCommandsResponse{ScheduledCommands: make([]scheduledCommandResponse, len(entries))}
+
+	for i, entry := range entries {
+		response.ScheduledCommands[i] = scheduledCommandResponse{
+			ID:          entry.ID,
+			CommandType: string(entry.CommandType),
+			Payload:     entry.Payload,
+			Cron:        entry.Cron,
+			Status:      string(entry.Status),
+			RunAt:       entry.RunAt,
+			Runs:        entry.Runs,
+			LastRunAt:   entry.LastRunAt,
+			LastError:   entry.LastError,
+			CreatedAt:   entry.CreatedAt,
+			UpdatedAt:   entry.UpdatedAt,
+		}
+	}
+
+	return response
+}
+
+// ListScheduledCommandsHandler returns an HTTP handler for scheduled commands listing.
+func ListScheduledCommandsHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parameters := r.URL.Query()
+
+		limit := uint64(listScheduledCommandsDefaultLimit)
+		if value := parameters.Get("limit"); value != "" {
+			parsed, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidLimitParameter)
+
+				return
+			}
+
+			limit = parsed
+		}
+
+		listScheduledCommandsQuery := query.NewListScheduledCommandsQuery(parameters.Get("status"), uint(limit))
+
+		entries, err := bus.Ask[query.ListScheduledCommandsQuery, []*scheduler.Entry](
+			r.Context(),
+			application.QueryBus,
+			listScheduledCommandsQuery,
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, toListScheduledCommandsResponse(entries), nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
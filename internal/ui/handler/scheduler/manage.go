@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"net/http"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/scheduler/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+// scheduledCommandIDResponse represents cancel and trigger scheduled command endpoints response structure.
+type scheduledCommandIDResponse struct {
+	ID string `json:"id"`
+}
+
+// CancelScheduledCommandHandler returns an HTTP handler for scheduled command cancellation.
+func CancelScheduledCommandHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := application.GetURLParam(r, "scheduledCommandId")
+
+		dispatchScheduledCommandCommand(application, w, r, id, command.NewCancelScheduledCommandCommand(id))
+	}
+}
+
+// TriggerScheduledCommandHandler returns an HTTP handler making scheduled command due immediately.
+func TriggerScheduledCommandHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := application.GetURLParam(r, "scheduledCommandId")
+
+		dispatchScheduledCommandCommand(application, w, r, id, command.NewTriggerScheduledCommandCommand(id))
+	}
+}
+
+// dispatchScheduledCommandCommand dispatches scheduled command management command and writes its outcome.
+func dispatchScheduledCommandCommand(
+	application *infrastructure.Application,
+	w http.ResponseWriter,
+	r *http.Request,
+	id string,
+	managementCommand bus.Command,
+) {
+	if err := application.CommandBus.Dispatch(r.Context(), managementCommand); err != nil {
+		application.HttpErrorResponse(w, r, err)
+
+		return
+	}
+
+	if err := application.Marshall(w, http.StatusOK, scheduledCommandIDResponse{ID: id}, nil); err != nil {
+		application.HttpErrorResponse(w, r, err)
+
+		return
+	}
+}
@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/scheduler/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+// scheduleCommandRequest represents schedule command endpoint structure.
+type scheduleCommandRequest struct {
+	CommandType string          `json:"commandType" validate:"required"`
+	Payload     json.RawMessage `json:"payload" validate:"required"`
+	RunAt       time.Time       `json:"runAt" validate:"required_without=Cron"`
+	Cron        string          `json:"cron" validate:"required_without=RunAt"`
+}
+
+// ScheduleCommandHandler returns an HTTP handler scheduling command to be dispatched once at run time or by cron.
+func ScheduleCommandHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request scheduleCommandRequest
+
+		if err := application.Unmarshall(w, r, &request); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.ValidateRequest(request); err != nil {
+			application.ValidationErrorResponse(w, r, err)
+
+			return
+		}
+
+		id := uuid.New().String()
+		scheduleCommand := command.NewScheduleCommandCommand(id, request.CommandType, request.Payload, request.RunAt, request.Cron)
+
+		if err := application.CommandBus.Dispatch(r.Context(), scheduleCommand); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusCreated, scheduledCommandIDResponse{ID: id}, nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
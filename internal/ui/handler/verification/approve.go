@@ -17,10 +17,23 @@ func ApproveVerificationHandler(application *infrastructure.Application) func(ht
 	return func(w http.ResponseWriter, r *http.Request) {
 		verificationUUID := application.GetURLParam(r, "verificationUuid")
 
-		approveCommand := command.NewApproveVerificationCommand(verificationUUID)
+		approveCommand := command.NewApproveVerificationCommand(verificationUUID).WithActor(application.Actor(r))
+
+		if application.RespondAsync(r) {
+			jobID, err := application.AsyncCommandBus.Enqueue(r.Context(), approveCommand)
+			if err != nil {
+				application.HttpErrorResponse(w, r, err)
+
+				return
+			}
+
+			application.AcceptedResponse(w, r, jobID)
+
+			return
+		}
 
 		if err := application.CommandBus.Dispatch(r.Context(), approveCommand); err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
@@ -28,7 +41,7 @@ func ApproveVerificationHandler(application *infrastructure.Application) func(ht
 		response := approveVerificationResponse{UUID: verificationUUID}
 
 		if err := application.Marshall(w, http.StatusOK, response, nil); err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
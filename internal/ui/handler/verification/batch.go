@@ -0,0 +1,124 @@
+package verification
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/batch"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const (
+	createOperation  = "create"
+	approveOperation = "approve"
+	declineOperation = "decline"
+	cancelOperation  = "cancel"
+)
+
+// batchOperationRequest represents single operation of batch verification endpoint structure.
+type batchOperationRequest struct {
+	Operation         string `json:"operation" validate:"required,oneof=create approve decline cancel"`
+	UUID              string `json:"uuid"`
+	Kind              string `json:"kind"`
+	Description       string `json:"description"`
+	ExternalReference string `json:"externalReference"`
+	DeclineReason     string `json:"declineReason"`
+}
+
+// batchVerificationRequest represents batch verification endpoint structure.
+type batchVerificationRequest struct {
+	Transactional bool                    `json:"transactional"`
+	Operations    []batchOperationRequest `json:"operations" validate:"required,min=1,max=500,dive"`
+}
+
+// batchOperationResult represents single operation result of batch verification endpoint response structure.
+type batchOperationResult struct {
+	Operation string `json:"operation"`
+	UUID      string `json:"uuid"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+// batchVerificationResponse represents batch verification endpoint response structure.
+type batchVerificationResponse struct {
+	Results []batchOperationResult `json:"results"`
+}
+
+// BatchVerificationHandler returns an HTTP handler for several verification operations at once.
+// Each operation is dispatched separately unless transactional mode is requested.
+func BatchVerificationHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request batchVerificationRequest
+
+		if err := application.Unmarshall(w, r, &request); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.ValidateRequest(request); err != nil {
+			application.ValidationErrorResponse(w, r, err)
+
+			return
+		}
+
+		actor := application.Actor(r)
+		commands := make([]bus.Command, len(request.Operations))
+		response := batchVerificationResponse{Results: make([]batchOperationResult, len(request.Operations))}
+
+		for i, operation := range request.Operations {
+			commands[i], response.Results[i].UUID = toBatchCommand(operation, actor)
+			response.Results[i].Operation = operation.Operation
+		}
+
+		dispatcher := batch.NewDispatcher(application.CommandBus, application.UnitOfWork)
+
+		var results []batch.Result
+
+		if request.Transactional {
+			results = dispatcher.DispatchAtomically(r.Context(), commands)
+		} else {
+			results = dispatcher.Dispatch(r.Context(), commands)
+		}
+
+		for i, result := range results {
+			response.Results[i].Status = string(result.Status)
+
+			if result.Err != nil {
+				response.Results[i].Error = application.ErrorMessage(result.Err)
+			}
+		}
+
+		if err := application.Marshall(w, http.StatusOK, response, nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
+
+// toBatchCommand converts batch operation to command and returns uuid of affected verification.
+// Verification uuid is generated for create operation without one.
+func toBatchCommand(operation batchOperationRequest, actor string) (bus.Command, string) {
+	switch operation.Operation {
+	case createOperation:
+		if operation.UUID == "" {
+			operation.UUID = uuid.New().String()
+		}
+
+		// invalid uuid becomes uuid.Nil and is rejected by command validation
+		verificationUUID, _ := uuid.Parse(operation.UUID)
+
+		return command.NewCreateVerificationCommand(verificationUUID, operation.Description, operation.Kind).
+			WithExternalReference(operation.ExternalReference).
+			WithActor(actor), operation.UUID
+	case approveOperation:
+		return command.NewApproveVerificationCommand(operation.UUID).WithActor(actor), operation.UUID
+	case declineOperation:
+		return command.NewDeclineVerificationCommand(operation.UUID, operation.DeclineReason).WithActor(actor), operation.UUID
+	default:
+		return command.NewCancelVerificationCommand(operation.UUID).WithActor(actor), operation.UUID
+	}
+}
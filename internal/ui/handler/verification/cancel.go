@@ -0,0 +1,49 @@
+package verification
+
+import (
+	"net/http"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+// cancelVerificationResponse represents cancel verification endpoint response structure.
+type cancelVerificationResponse struct {
+	UUID string `json:"uuid"`
+}
+
+// CancelVerificationHandler returns an HTTP handler for verification cancellation.
+func CancelVerificationHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		verificationUUID := application.GetURLParam(r, "verificationUuid")
+
+		cancelCommand := command.NewCancelVerificationCommand(verificationUUID).WithActor(application.Actor(r))
+
+		if application.RespondAsync(r) {
+			jobID, err := application.AsyncCommandBus.Enqueue(r.Context(), cancelCommand)
+			if err != nil {
+				application.HttpErrorResponse(w, r, err)
+
+				return
+			}
+
+			application.AcceptedResponse(w, r, jobID)
+
+			return
+		}
+
+		if err := application.CommandBus.Dispatch(r.Context(), cancelCommand); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		response := cancelVerificationResponse{UUID: verificationUUID}
+
+		if err := application.Marshall(w, http.StatusOK, response, nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
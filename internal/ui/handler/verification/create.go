@@ -10,8 +10,9 @@ import (
 
 // createVerificationRequest represents create verification endpoint structure.
 type createVerificationRequest struct {
-	Description string `json:"description" validate:"required,min=10"`
-	Kind        string `json:"kind" validate:"required,alpha,oneof=identity document"`
+	Description       string `json:"description" validate:"required,min=10"`
+	Kind              string `json:"kind" validate:"required,alpha,oneof=identity document"`
+	ExternalReference string `json:"externalReference" validate:"max=255"`
 }
 
 // createVerificationResponse represents create verification endpoint response structure.
@@ -25,22 +26,24 @@ func CreateVerificationHandler(application *infrastructure.Application) func(htt
 		var request createVerificationRequest
 
 		if err := application.Unmarshall(w, r, &request); err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
 
 		if err := application.ValidateRequest(request); err != nil {
-			application.ValidationErrorResponse(w, err)
+			application.ValidationErrorResponse(w, r, err)
 
 			return
 		}
 
 		verificationUUID := uuid.New()
-		createCommand := command.NewCreateVerificationCommand(verificationUUID, request.Description, request.Kind)
+		createCommand := command.NewCreateVerificationCommand(verificationUUID, request.Description, request.Kind).
+			WithExternalReference(request.ExternalReference).
+			WithActor(application.Actor(r))
 
 		if err := application.CommandBus.Dispatch(r.Context(), createCommand); err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
@@ -48,7 +51,7 @@ func CreateVerificationHandler(application *infrastructure.Application) func(htt
 		response := createVerificationResponse{UUID: verificationUUID}
 
 		if err := application.Marshall(w, http.StatusCreated, response, nil); err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
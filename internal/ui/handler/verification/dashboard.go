@@ -0,0 +1,118 @@
+package verification
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const dashboardDefaultLimit = 50
+
+var ErrInvalidPaginationParameter = infrastructure.NewCodedError("invalid_pagination_parameter", "limit and offset parameters must be non-negative integers")
+
+// dashboardVerificationResponse represents single verification of dashboard endpoint response structure.
+type dashboardVerificationResponse struct {
+	UUID          string    `json:"uuid"`
+	Kind          string    `json:"kind"`
+	Description   string    `json:"description"`
+	Status        string    `json:"status"`
+	DeclineReason string    `json:"declineReason,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// dashboardResponse represents verifications dashboard endpoint response structure.
+type dashboardResponse struct {
+	Verifications []dashboardVerificationResponse `json:"verifications"`
+	Counts        map[string]uint                 `json:"counts"`
+}
+
+// toDashboardResponse create dashboardResponse from read model views and status counts.
+func toDashboardResponse(views []*readmodel.VerificationView, counts map[string]uint) dashboardResponse {
+	response := dashboardResponse{
+		Verifications: make([]dashboardVerificationResponse, len(views)),
+		Counts:        counts,
+	}
+
+	for i, view := range views {
+		response.Verifications[i] = dashboardVerificationResponse{
+			UUID:          view.UUID,
+			Kind:          view.Kind,
+			Description:   view.Description,
+			Status:        view.Status,
+			DeclineReason: view.DeclineReason,
+			CreatedAt:     view.CreatedAt,
+		}
+	}
+
+	return response
+}
+
+// DashboardHandler returns an HTTP handler listing verifications with counts per status from the read model.
+func DashboardHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parameters := r.URL.Query()
+
+		limit, err := parseUintParameter(parameters.Get("limit"), dashboardDefaultLimit)
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidPaginationParameter)
+
+			return
+		}
+
+		offset, err := parseUintParameter(parameters.Get("offset"), 0)
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidPaginationParameter)
+
+			return
+		}
+
+		kind := parameters.Get("kind")
+
+		views, err := bus.Ask[query.ListVerificationViewsQuery, []*readmodel.VerificationView](
+			r.Context(),
+			application.QueryBus,
+			query.NewListVerificationViewsQuery(parameters.Get("status"), kind, limit, offset),
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		counts, err := bus.Ask[query.CountVerificationViewsQuery, map[string]uint](
+			r.Context(),
+			application.QueryBus,
+			query.NewCountVerificationViewsQuery(kind),
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, toDashboardResponse(views, counts), nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
+
+// parseUintParameter parses optional non-negative integer query parameter.
+func parseUintParameter(value string, defaultValue uint) (uint, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint(parsed), nil
+}
@@ -23,22 +23,36 @@ func DeclineVerificationHandler(application *infrastructure.Application) func(ht
 		var request declineVerificationRequest
 
 		if err := application.Unmarshall(w, r, &request); err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
 
 		if err := application.ValidateRequest(request); err != nil {
-			application.ValidationErrorResponse(w, err)
+			application.ValidationErrorResponse(w, r, err)
 
 			return
 		}
 
 		verificationUUID := application.GetURLParam(r, "verificationUuid")
-		declineCommand := command.NewDeclineVerificationCommand(verificationUUID, request.DeclineReason)
+		declineCommand := command.NewDeclineVerificationCommand(verificationUUID, request.DeclineReason).
+			WithActor(application.Actor(r))
+
+		if application.RespondAsync(r) {
+			jobID, err := application.AsyncCommandBus.Enqueue(r.Context(), declineCommand)
+			if err != nil {
+				application.HttpErrorResponse(w, r, err)
+
+				return
+			}
+
+			application.AcceptedResponse(w, r, jobID)
+
+			return
+		}
 
 		if err := application.CommandBus.Dispatch(r.Context(), declineCommand); err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
@@ -46,7 +60,7 @@ func DeclineVerificationHandler(application *infrastructure.Application) func(ht
 		response := declineVerificationResponse{UUID: verificationUUID}
 
 		if err := application.Marshall(w, http.StatusOK, response, nil); err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
@@ -0,0 +1,161 @@
+package verification
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/events"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const (
+	eventStreamContentType = "text/event-stream"
+	lastEventIDHeader      = "Last-Event-ID"
+	statusChangedEvent     = "status_changed"
+)
+
+var ErrStreamingUnsupported = errors.New("response streaming is not supported")
+
+// verificationEventResponse represents data of verification event stream message.
+type verificationEventResponse struct {
+	UUID          string    `json:"uuid"`
+	Kind          string    `json:"kind"`
+	Status        string    `json:"status"`
+	DeclineReason string    `json:"declineReason,omitempty"`
+	Actor         string    `json:"actor"`
+	OccurredAt    time.Time `json:"occurredAt"`
+}
+
+// eventStreamSink writes events to response as Server-Sent Events and flushes every message at once.
+// Headers are deferred until stream is open, so errors found before are reported with regular error response.
+type eventStreamSink struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	opened  bool
+}
+
+// Open implements events.Sink interface.
+func (s *eventStreamSink) Open() error {
+	s.opened = true
+
+	s.w.Header().Set("Content-Type", eventStreamContentType)
+	// disable response buffering of nginx ingress
+	s.w.Header().Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+	s.flusher.Flush()
+
+	return nil
+}
+
+// Send implements events.Sink interface.
+func (s *eventStreamSink) Send(event events.Event) error {
+	data, err := json.Marshal(verificationEventResponse{
+		UUID:          event.VerificationUUID,
+		Kind:          event.Kind,
+		Status:        event.Status,
+		DeclineReason: event.Reason,
+		Actor:         event.Actor,
+		OccurredAt:    event.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.write(fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", event.Position, statusChangedEvent, data))
+}
+
+// Heartbeat implements events.Sink interface. Comment line is ignored by clients.
+func (s *eventStreamSink) Heartbeat() error {
+	return s.write(": heartbeat\n\n")
+}
+
+// write writes message to response and flushes it to client.
+func (s *eventStreamSink) write(message string) error {
+	if _, err := s.w.Write([]byte(message)); err != nil {
+		return err
+	}
+
+	s.flusher.Flush()
+
+	return nil
+}
+
+// VerificationEventsHandler returns an HTTP handler streaming status changes of single verification as Server-Sent Events.
+// Stream starts with verification history, so client waiting for decision learns the current status at once.
+func VerificationEventsHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		streamEvents(application, w, r, query.StreamVerificationEventsFilter{
+			UUID:        application.GetURLParam(r, "verificationUuid"),
+			LastEventID: lastEventID(r),
+		})
+	}
+}
+
+// EventsHandler returns an HTTP handler streaming status changes of all verifications filtered by kind and status
+// as Server-Sent Events. Stream starts with changes made after connection unless it is resumed.
+func EventsHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parameters := r.URL.Query()
+
+		var statuses []string
+		for _, value := range parameters["status"] {
+			statuses = append(statuses, strings.Split(value, ",")...)
+		}
+
+		streamEvents(application, w, r, query.StreamVerificationEventsFilter{
+			Kind:        parameters.Get("kind"),
+			Statuses:    statuses,
+			LastEventID: lastEventID(r),
+		})
+	}
+}
+
+// lastEventID returns id of the last event client received, sent by EventSource on reconnection in "Last-Event-ID" header.
+// Clients resuming stream after reload pass it in "lastEventId" query parameter.
+func lastEventID(r *http.Request) string {
+	if value := r.Header.Get(lastEventIDHeader); value != "" {
+		return value
+	}
+
+	return r.URL.Query().Get("lastEventId")
+}
+
+// streamEvents streams verification events matching filter to response until client disconnects or server shuts down.
+func streamEvents(
+	application *infrastructure.Application,
+	w http.ResponseWriter,
+	r *http.Request,
+	filter query.StreamVerificationEventsFilter,
+) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		application.HttpErrorResponse(w, r, ErrStreamingUnsupported)
+
+		return
+	}
+
+	sink := &eventStreamSink{w: w, flusher: flusher}
+
+	sent, err := bus.Ask[query.StreamVerificationEventsQuery, uint](
+		r.Context(),
+		application.QueryBus,
+		query.NewStreamVerificationEventsQuery(filter, sink),
+	)
+	if err != nil {
+		if !sink.opened {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		// stream is already open, client reconnects and resumes from the last received event
+		log.Printf("verification events stream failed after %d events: %v", sent, err)
+	}
+}
@@ -0,0 +1,103 @@
+package verification
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/export"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+// exportContentTypes maps export format to response content type.
+var exportContentTypes = map[string]string{
+	export.FormatCSV:    "text/csv; charset=utf-8",
+	export.FormatNDJSON: "application/x-ndjson",
+}
+
+// exportResponseWriter defers export response headers until the first exported byte,
+// so errors found before streaming starts are still reported with regular error response.
+type exportResponseWriter struct {
+	w       http.ResponseWriter
+	format  string
+	started bool
+}
+
+// Write implements io.Writer interface.
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+
+		w.w.Header().Set("Content-Type", exportContentTypes[w.format])
+		w.w.Header().Set(
+			"Content-Disposition",
+			fmt.Sprintf(`attachment; filename="verifications-%s.%s"`, time.Now().UTC().Format("20060102T150405Z"), w.format),
+		)
+		w.w.WriteHeader(http.StatusOK)
+	}
+
+	return w.w.Write(p)
+}
+
+// ExportVerificationsHandler returns an HTTP handler streaming verifications matching listing filters as CSV or NDJSON.
+func ExportVerificationsHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parameters := r.URL.Query()
+
+		createdFrom, err := parseTimeParameter(parameters.Get("createdFrom"))
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidDateParameter)
+
+			return
+		}
+
+		createdTo, err := parseTimeParameter(parameters.Get("createdTo"))
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidDateParameter)
+
+			return
+		}
+
+		var columns []string
+		if value := parameters.Get("columns"); value != "" {
+			columns = strings.Split(value, ",")
+		}
+
+		format := parameters.Get("format")
+		if format == "" {
+			format = export.FormatCSV
+		}
+
+		out := &exportResponseWriter{w: w, format: format}
+
+		exported, err := bus.Ask[query.ExportVerificationsQuery, uint](
+			r.Context(),
+			application.QueryBus,
+			query.NewExportVerificationsQuery(query.ExportVerificationsFilter{
+				Status:            parameters.Get("status"),
+				Kind:              parameters.Get("kind"),
+				ExternalReference: parameters.Get("externalReference"),
+				CreatedFrom:       createdFrom,
+				CreatedTo:         createdTo,
+				Sort:              parameters.Get("sort"),
+				Format:            format,
+				Columns:           columns,
+			}, out),
+		)
+		if err != nil {
+			if !out.started {
+				application.HttpErrorResponse(w, r, err)
+
+				return
+			}
+
+			// response is already partially sent, abort connection so client does not take it for complete export
+			log.Printf("verifications export failed after %d rows: %v", exported, err)
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
 	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
 	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
 	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
@@ -11,46 +12,55 @@ import (
 
 // getVerificationByUUIDResponse represents get verification by uuid endpoint response structure.
 type getVerificationByUUIDResponse struct {
-	ID            uint32    `json:"id"`
-	UUID          string    `json:"uuid"`
-	Kind          string    `json:"kind"`
-	Description   string    `json:"description"`
-	Status        string    `json:"status"`
-	DeclineReason string    `json:"declineReason,omitempty"`
-	CreatedAt     time.Time `json:"createdAt"`
+	ID                uint32    `json:"id"`
+	UUID              string    `json:"uuid"`
+	Kind              string    `json:"kind"`
+	Description       string    `json:"description"`
+	Status            string    `json:"status"`
+	DeclineReason     string    `json:"declineReason,omitempty"`
+	ExternalReference string    `json:"externalReference,omitempty"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
 }
 
 // toVerificationByUUIDResponse create getVerificationByUUIDResponse from aggregate.Verification.
 func toVerificationByUUIDResponse(verification *aggregate.Verification) *getVerificationByUUIDResponse {
 	return &getVerificationByUUIDResponse{
-		ID:            verification.ID().Value(),
-		UUID:          verification.UUID().Value(),
-		Kind:          verification.Kind().Value(),
-		Description:   verification.Description().Value(),
-		Status:        verification.Status().Value(),
-		DeclineReason: verification.DeclineReason().Value(),
-		CreatedAt:     verification.CreatedAt(),
+		ID:                verification.ID().Value(),
+		UUID:              verification.UUID().Value(),
+		Kind:              verification.Kind().Value(),
+		Description:       verification.Description().Value(),
+		Status:            verification.Status().Value(),
+		DeclineReason:     verification.DeclineReason().Value(),
+		ExternalReference: verification.ExternalReference().Value(),
+		CreatedAt:         verification.CreatedAt(),
+		UpdatedAt:         verification.UpdatedAt(),
 	}
 }
 
 // GetVerificationHandler returns an HTTP handler for verification fetching.
+// Response is tagged with ETag and Last-Modified, so polling clients revalidate their copy with conditional requests.
 func GetVerificationHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		verificationUUID := application.GetURLParam(r, "verificationUuid")
 
 		getVerificationByUUIDQuery := query.NewGetVerificationByUUIDQuery(verificationUUID)
 
-		verification, err := application.QueryBus.Ask(r.Context(), getVerificationByUUIDQuery)
+		verification, err := bus.Ask[query.GetVerificationByUUIDQuery, *aggregate.Verification](
+			r.Context(),
+			application.QueryBus,
+			getVerificationByUUIDQuery,
+		)
 		if err != nil {
-			application.HttpErrorResponse(w, err)
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
 
-		response := toVerificationByUUIDResponse(verification.(*aggregate.Verification))
+		response := toVerificationByUUIDResponse(verification)
 
-		if err := application.Marshall(w, http.StatusOK, response, nil); err != nil {
-			application.HttpErrorResponse(w, err)
+		if err := application.ConditionalResponse(w, r, response, verification.UpdatedAt()); err != nil {
+			application.HttpErrorResponse(w, r, err)
 
 			return
 		}
@@ -0,0 +1,102 @@
+package verification
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const listVerificationsDefaultLimit = 50
+
+var (
+	ErrInvalidLimitParameter = infrastructure.NewCodedError("invalid_limit_parameter", "limit parameter must be a positive integer")
+	ErrInvalidDateParameter  = infrastructure.NewCodedError("invalid_date_parameter", "createdFrom and createdTo parameters must be RFC 3339 date-times")
+)
+
+// listVerificationsResponse represents list verifications endpoint response structure.
+type listVerificationsResponse struct {
+	Verifications []*getVerificationByUUIDResponse `json:"verifications"`
+	NextCursor    string                           `json:"nextCursor,omitempty"`
+}
+
+// toListVerificationsResponse create listVerificationsResponse from query.VerificationsPage.
+func toListVerificationsResponse(page query.VerificationsPage) listVerificationsResponse {
+	response := listVerificationsResponse{
+		Verifications: make([]*getVerificationByUUIDResponse, len(page.Verifications)),
+		NextCursor:    page.NextCursor,
+	}
+
+	for i, verification := range page.Verifications {
+		response.Verifications[i] = toVerificationByUUIDResponse(verification)
+	}
+
+	return response
+}
+
+// ListVerificationsHandler returns an HTTP handler listing verifications with filters, sorting and cursor pagination.
+func ListVerificationsHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parameters := r.URL.Query()
+
+		limit, err := parseUintParameter(parameters.Get("limit"), listVerificationsDefaultLimit)
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidLimitParameter)
+
+			return
+		}
+
+		createdFrom, err := parseTimeParameter(parameters.Get("createdFrom"))
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidDateParameter)
+
+			return
+		}
+
+		createdTo, err := parseTimeParameter(parameters.Get("createdTo"))
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidDateParameter)
+
+			return
+		}
+
+		listVerificationsQuery := query.NewListVerificationsQuery(query.ListVerificationsFilter{
+			Status:            parameters.Get("status"),
+			Kind:              parameters.Get("kind"),
+			ExternalReference: parameters.Get("externalReference"),
+			CreatedFrom:       createdFrom,
+			CreatedTo:         createdTo,
+			Sort:              parameters.Get("sort"),
+			Cursor:            parameters.Get("cursor"),
+			Limit:             limit,
+		})
+
+		page, err := bus.Ask[query.ListVerificationsQuery, query.VerificationsPage](
+			r.Context(),
+			application.QueryBus,
+			listVerificationsQuery,
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, toListVerificationsResponse(page), nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
+
+// parseTimeParameter parses optional RFC 3339 date-time query parameter.
+func parseTimeParameter(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,90 @@
+package verification
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/command"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/domain/verification/aggregate"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+var ErrVerificationConflict = infrastructure.NewCodedError("verification_conflict", "verification with the same uuid but different content already exists")
+
+// PutVerificationHandler returns an HTTP handler for verification creation with client supplied uuid.
+// Repeated request with identical content is answered with http.StatusOK, different content is a conflict.
+func PutVerificationHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		verificationUUID, err := uuid.Parse(application.GetURLParam(r, "verificationUuid"))
+		if err != nil {
+			application.HttpErrorResponse(w, r, fmt.Errorf("%w: %s", aggregate.ErrInvalidVerificationUUID, err))
+
+			return
+		}
+
+		var request createVerificationRequest
+
+		if err := application.Unmarshall(w, r, &request); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.ValidateRequest(request); err != nil {
+			application.ValidationErrorResponse(w, r, err)
+
+			return
+		}
+
+		status := http.StatusCreated
+		createCommand := command.NewCreateVerificationCommand(verificationUUID, request.Description, request.Kind).
+			WithExternalReference(request.ExternalReference).
+			WithActor(application.Actor(r))
+
+		if err := application.CommandBus.Dispatch(r.Context(), createCommand); err != nil {
+			if !errors.Is(err, aggregate.ErrVerificationAlreadyExists) {
+				application.HttpErrorResponse(w, r, err)
+
+				return
+			}
+
+			existing, err := bus.Ask[query.GetVerificationByUUIDQuery, *aggregate.Verification](
+				r.Context(),
+				application.QueryBus,
+				query.NewGetVerificationByUUIDQuery(verificationUUID.String()),
+			)
+			if err != nil {
+				application.HttpErrorResponse(w, r, err)
+
+				return
+			}
+
+			if !isSameVerification(existing, request) {
+				application.ErrorResponse(w, r, http.StatusConflict, ErrVerificationConflict)
+
+				return
+			}
+
+			status = http.StatusOK
+		}
+
+		response := createVerificationResponse{UUID: verificationUUID}
+
+		if err := application.Marshall(w, status, response, nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
+
+// isSameVerification reports whether existing verification was created from the same request.
+func isSameVerification(verification *aggregate.Verification, request createVerificationRequest) bool {
+	return verification.Kind().Value() == request.Kind &&
+		verification.Description().Value() == request.Description &&
+		verification.ExternalReference().Value() == request.ExternalReference
+}
@@ -0,0 +1,83 @@
+package verification
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/readmodel"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const searchVerificationsDefaultLimit = 20
+
+// searchResultResponse represents single verification of search endpoint response structure.
+type searchResultResponse struct {
+	UUID          string    `json:"uuid"`
+	Kind          string    `json:"kind"`
+	Description   string    `json:"description"`
+	Status        string    `json:"status"`
+	DeclineReason string    `json:"declineReason,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Rank          float64   `json:"rank"`
+	Snippet       string    `json:"snippet"`
+}
+
+// searchVerificationsResponse represents search verifications endpoint response structure.
+type searchVerificationsResponse struct {
+	Results []searchResultResponse `json:"results"`
+}
+
+// toSearchVerificationsResponse create searchVerificationsResponse from read model search results.
+func toSearchVerificationsResponse(results []*readmodel.SearchResult) searchVerificationsResponse {
+	response := searchVerificationsResponse{
+		Results: make([]searchResultResponse, len(results)),
+	}
+
+	for i, result := range results {
+		response.Results[i] = searchResultResponse{
+			UUID:          result.View.UUID,
+			Kind:          result.View.Kind,
+			Description:   result.View.Description,
+			Status:        result.View.Status,
+			DeclineReason: result.View.DeclineReason,
+			CreatedAt:     result.View.CreatedAt,
+			Rank:          result.Rank,
+			Snippet:       result.Snippet,
+		}
+	}
+
+	return response
+}
+
+// SearchVerificationsHandler returns an HTTP handler full-text searching verifications by description and decline reason.
+func SearchVerificationsHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parameters := r.URL.Query()
+
+		limit, err := parseUintParameter(parameters.Get("limit"), searchVerificationsDefaultLimit)
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidLimitParameter)
+
+			return
+		}
+
+		results, err := bus.Ask[query.SearchVerificationsQuery, []*readmodel.SearchResult](
+			r.Context(),
+			application.QueryBus,
+			query.NewSearchVerificationsQuery(parameters.Get("q"), limit),
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, toSearchVerificationsResponse(results), nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
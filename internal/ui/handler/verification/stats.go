@@ -0,0 +1,122 @@
+package verification
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+const (
+	statsDefaultPeriod = 30 * 24 * time.Hour
+	statsDayLayout     = "2006-01-02"
+)
+
+var ErrInvalidPeriodParameter = infrastructure.NewCodedError("invalid_period_parameter", "from and to parameters must be RFC 3339 date-times")
+
+// timeToDecisionResponse represents time-to-decision percentiles of stats endpoint response structure.
+type timeToDecisionResponse struct {
+	Decided    uint    `json:"decided"`
+	P50Seconds float64 `json:"p50Seconds"`
+	P90Seconds float64 `json:"p90Seconds"`
+}
+
+// dailyBucketResponse represents single day of stats endpoint response structure.
+type dailyBucketResponse struct {
+	Date     string `json:"date"`
+	Created  uint   `json:"created"`
+	Approved uint   `json:"approved"`
+	Declined uint   `json:"declined"`
+}
+
+// verificationStatsResponse represents verification stats endpoint response structure.
+type verificationStatsResponse struct {
+	From           time.Time              `json:"from"`
+	To             time.Time              `json:"to"`
+	Total          uint                   `json:"total"`
+	ByStatus       map[string]uint        `json:"byStatus"`
+	ByKind         map[string]uint        `json:"byKind"`
+	ApprovalRate   float64                `json:"approvalRate"`
+	DeclineRate    float64                `json:"declineRate"`
+	TimeToDecision timeToDecisionResponse `json:"timeToDecision"`
+	Daily          []dailyBucketResponse  `json:"daily"`
+}
+
+// toVerificationStatsResponse create verificationStatsResponse from query.VerificationStats.
+func toVerificationStatsResponse(stats query.VerificationStats) verificationStatsResponse {
+	response := verificationStatsResponse{
+		From:         stats.Period.From,
+		To:           stats.Period.To,
+		Total:        stats.Total,
+		ByStatus:     stats.ByStatus,
+		ByKind:       stats.ByKind,
+		ApprovalRate: stats.ApprovalRate,
+		DeclineRate:  stats.DeclineRate,
+		TimeToDecision: timeToDecisionResponse{
+			Decided:    stats.DecisionTime.Decided,
+			P50Seconds: stats.DecisionTime.P50.Seconds(),
+			P90Seconds: stats.DecisionTime.P90.Seconds(),
+		},
+		Daily: make([]dailyBucketResponse, len(stats.Daily)),
+	}
+
+	for i, bucket := range stats.Daily {
+		response.Daily[i] = dailyBucketResponse{
+			Date:     bucket.Day.Format(statsDayLayout),
+			Created:  bucket.Created,
+			Approved: bucket.Approved,
+			Declined: bucket.Declined,
+		}
+	}
+
+	return response
+}
+
+// VerificationStatsHandler returns an HTTP handler reporting verification throughput over requested period.
+// Period defaults to the last 30 days.
+func VerificationStatsHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parameters := r.URL.Query()
+
+		to, err := parseTimeParameter(parameters.Get("to"))
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidPeriodParameter)
+
+			return
+		}
+
+		if to.IsZero() {
+			to = time.Now()
+		}
+
+		from, err := parseTimeParameter(parameters.Get("from"))
+		if err != nil {
+			application.ErrorResponse(w, r, http.StatusBadRequest, ErrInvalidPeriodParameter)
+
+			return
+		}
+
+		if from.IsZero() {
+			from = to.Add(-statsDefaultPeriod)
+		}
+
+		stats, err := bus.Ask[query.VerificationStatsQuery, query.VerificationStats](
+			r.Context(),
+			application.QueryBus,
+			query.NewVerificationStatsQuery(from, to),
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, toVerificationStatsResponse(stats), nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package verification
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/vitalii-tkachuk/verification-service/internal/application/shared/bus"
+	"github.com/vitalii-tkachuk/verification-service/internal/application/verification/query"
+	"github.com/vitalii-tkachuk/verification-service/internal/infrastructure"
+)
+
+// timelineEntryResponse represents single entry of verification timeline endpoint response structure.
+type timelineEntryResponse struct {
+	Type          string    `json:"type"`
+	Status        string    `json:"status"`
+	Reason        string    `json:"reason,omitempty"`
+	Actor         string    `json:"actor"`
+	ActorVerified bool      `json:"actorVerified"`
+	Automated     bool      `json:"automated"`
+	OccurredAt    time.Time `json:"occurredAt"`
+}
+
+// timelineResponse represents verification timeline endpoint response structure.
+type timelineResponse struct {
+	UUID    string                  `json:"uuid"`
+	Entries []timelineEntryResponse `json:"entries"`
+}
+
+// toTimelineResponse create timelineResponse from query.TimelineEntry list.
+func toTimelineResponse(verificationUUID string, timeline []query.TimelineEntry) timelineResponse {
+	response := timelineResponse{
+		UUID:    verificationUUID,
+		Entries: make([]timelineEntryResponse, len(timeline)),
+	}
+
+	for i, entry := range timeline {
+		response.Entries[i] = timelineEntryResponse{
+			Type:          entry.Type,
+			Status:        entry.Status,
+			Reason:        entry.Reason,
+			Actor:         entry.Actor,
+			ActorVerified: entry.ActorVerified,
+			Automated:     entry.Automated,
+			OccurredAt:    entry.OccurredAt,
+		}
+	}
+
+	return response
+}
+
+// GetVerificationTimelineHandler returns an HTTP handler listing verification history in chronological order.
+func GetVerificationTimelineHandler(application *infrastructure.Application) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		verificationUUID := application.GetURLParam(r, "verificationUuid")
+
+		timeline, err := bus.Ask[query.GetVerificationTimelineQuery, []query.TimelineEntry](
+			r.Context(),
+			application.QueryBus,
+			query.NewGetVerificationTimelineQuery(verificationUUID),
+		)
+		if err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+
+		if err := application.Marshall(w, http.StatusOK, toTimelineResponse(verificationUUID, timeline), nil); err != nil {
+			application.HttpErrorResponse(w, r, err)
+
+			return
+		}
+	}
+}
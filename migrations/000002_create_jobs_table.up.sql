@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS jobs(
+    id UUID PRIMARY KEY,
+    command_type VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE verifications DROP CONSTRAINT IF EXISTS verifications_uuid_key;
@@ -0,0 +1 @@
+ALTER TABLE verifications ADD CONSTRAINT verifications_uuid_key UNIQUE (uuid);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS sagas(
+    saga_type VARCHAR(100) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    step VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    error VARCHAR NOT NULL DEFAULT '',
+    timeout_at TIMESTAMP(0) WITHOUT TIME ZONE,
+    version INTEGER NOT NULL,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (saga_type, aggregate_id)
+);
+
+CREATE INDEX IF NOT EXISTS sagas_status_timeout_at_idx ON sagas (status, timeout_at);
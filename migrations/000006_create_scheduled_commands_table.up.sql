@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS scheduled_commands(
+    id UUID PRIMARY KEY,
+    command_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    cron VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    run_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+    runs INTEGER NOT NULL DEFAULT 0,
+    last_run_at TIMESTAMP(0) WITHOUT TIME ZONE,
+    last_error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS scheduled_commands_status_run_at_idx ON scheduled_commands (status, run_at);
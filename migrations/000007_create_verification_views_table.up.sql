@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS verification_views(
+    uuid UUID PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL,
+    description VARCHAR NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    decline_reason VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+    projected_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS verification_views_status_created_at_idx ON verification_views (status, created_at DESC);
+CREATE INDEX IF NOT EXISTS verification_views_kind_created_at_idx ON verification_views (kind, created_at DESC);
+
+INSERT INTO verification_views (uuid, kind, description, status, decline_reason, created_at, projected_at)
+SELECT uuid, kind, description, status, COALESCE(decline_reason, ''), created_at, NOW()
+FROM verifications
+ON CONFLICT (uuid) DO NOTHING;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS dead_letters(
+    id UUID PRIMARY KEY,
+    command_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    error VARCHAR NOT NULL,
+    attempts INTEGER NOT NULL,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS dead_letters_created_at_idx ON dead_letters (created_at);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS command_queue(
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    command_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    ordering_key VARCHAR(100) NOT NULL DEFAULT '',
+    deliveries INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP WITHOUT TIME ZONE,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS command_queue_ordering_key_seq_idx ON command_queue (ordering_key, seq);
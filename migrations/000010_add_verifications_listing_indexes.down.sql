@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS verifications_kind_id_idx;
+DROP INDEX IF EXISTS verifications_status_id_idx;
+DROP INDEX IF EXISTS verifications_kind_created_at_id_idx;
+DROP INDEX IF EXISTS verifications_status_created_at_id_idx;
+DROP INDEX IF EXISTS verifications_created_at_id_idx;
@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS verifications_created_at_id_idx ON verifications (created_at, id);
+CREATE INDEX IF NOT EXISTS verifications_status_created_at_id_idx ON verifications (status, created_at, id);
+CREATE INDEX IF NOT EXISTS verifications_kind_created_at_id_idx ON verifications (kind, created_at, id);
+CREATE INDEX IF NOT EXISTS verifications_status_id_idx ON verifications (status, id);
+CREATE INDEX IF NOT EXISTS verifications_kind_id_idx ON verifications (kind, id);
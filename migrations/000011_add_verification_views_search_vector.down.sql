@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS verification_views_search_vector_idx;
+ALTER TABLE verification_views DROP COLUMN IF EXISTS search_vector;
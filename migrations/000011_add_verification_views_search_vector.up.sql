@@ -0,0 +1,10 @@
+ALTER TABLE verification_views ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+-- Backfill uses the default english text search configuration.
+-- When SEARCH_LANGUAGE is configured otherwise, run "projections rebuild" after migrating
+-- to index existing views with the configured language.
+UPDATE verification_views
+SET search_vector = to_tsvector('english', description || ' ' || decline_reason)
+WHERE search_vector IS NULL;
+
+CREATE INDEX IF NOT EXISTS verification_views_search_vector_idx ON verification_views USING GIN (search_vector);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS verifications_decided_at_idx;
+ALTER TABLE verifications DROP COLUMN IF EXISTS decided_at;
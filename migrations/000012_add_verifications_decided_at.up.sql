@@ -0,0 +1,3 @@
+ALTER TABLE verifications ADD COLUMN IF NOT EXISTS decided_at TIMESTAMP(0) WITHOUT TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS verifications_decided_at_idx ON verifications (decided_at) WHERE decided_at IS NOT NULL;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS verification_history(
+    id BIGSERIAL PRIMARY KEY,
+    verification_uuid UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    reason VARCHAR NOT NULL DEFAULT '',
+    actor VARCHAR(255) NOT NULL,
+    occurred_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS verification_history_verification_uuid_idx ON verification_history (verification_uuid, occurred_at, id);
+
+INSERT INTO verification_history (verification_uuid, status, reason, actor, occurred_at)
+SELECT uuid, 'draft', '', 'unknown', created_at
+FROM verifications;
+
+INSERT INTO verification_history (verification_uuid, status, reason, actor, occurred_at)
+SELECT v.uuid, v.status, COALESCE(v.decline_reason, ''), 'unknown', COALESCE(v.decided_at, vv.projected_at, v.created_at)
+FROM verifications v
+LEFT JOIN verification_views vv ON vv.uuid = v.uuid
+WHERE v.status <> 'draft';
@@ -0,0 +1,10 @@
+ALTER TABLE verifications ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP(0) WITHOUT TIME ZONE;
+
+UPDATE verifications v
+SET updated_at = COALESCE(
+    (SELECT MAX(h.occurred_at) FROM verification_history h WHERE h.verification_uuid = v.uuid),
+    v.decided_at,
+    v.created_at
+);
+
+ALTER TABLE verifications ALTER COLUMN updated_at SET NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS verification_history_transaction_id_idx;
+ALTER TABLE verification_history DROP COLUMN IF EXISTS transaction_id;
@@ -0,0 +1,3 @@
+ALTER TABLE verification_history ADD COLUMN IF NOT EXISTS transaction_id XID8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE INDEX IF NOT EXISTS verification_history_transaction_id_idx ON verification_history (transaction_id, id);
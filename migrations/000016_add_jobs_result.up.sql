@@ -0,0 +1 @@
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS result VARCHAR NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS verifications_external_reference_id_idx;
+ALTER TABLE verifications DROP COLUMN IF EXISTS external_reference;
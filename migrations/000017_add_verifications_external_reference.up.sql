@@ -0,0 +1,2 @@
+ALTER TABLE verifications ADD COLUMN IF NOT EXISTS external_reference VARCHAR(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS verifications_external_reference_id_idx ON verifications (external_reference, id);
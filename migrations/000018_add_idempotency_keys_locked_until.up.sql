@@ -0,0 +1,2 @@
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT 'epoch';
+ALTER TABLE idempotency_keys ALTER COLUMN locked_until DROP DEFAULT;
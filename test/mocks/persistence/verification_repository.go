@@ -52,6 +52,43 @@ func (_m *VerificationRepository) GetByUUID(ctx context.Context, uuid aggregate.
 	return r0, r1
 }
 
+// List provides a mocks function with given fields: ctx, filter
+func (_m *VerificationRepository) List(ctx context.Context, filter aggregate.VerificationFilter) ([]*aggregate.Verification, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []*aggregate.Verification
+	if rf, ok := ret.Get(0).(func(context.Context, aggregate.VerificationFilter) []*aggregate.Verification); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*aggregate.Verification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, aggregate.VerificationFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Stream provides a mock function with given fields: ctx, filter, fn
+func (_m *VerificationRepository) Stream(ctx context.Context, filter aggregate.VerificationFilter, fn func(*aggregate.Verification) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, aggregate.VerificationFilter, func(*aggregate.Verification) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mocks function with given fields: ctx, verification
 func (_m *VerificationRepository) Update(ctx context.Context, verification *aggregate.Verification) error {
 	ret := _m.Called(ctx, verification)